@@ -420,6 +420,106 @@ func WorkFlowTestResume(ctx context.Context, c *app.RequestContext) {
 	c.JSON(consts.StatusOK, resp)
 }
 
+// InspectVariables .
+// @router /api/workflow_api/inspect_variables [POST]
+func InspectVariables(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req workflow.InspectVariablesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := appworkflow.SVC.InspectVariables(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ExportNodeBatchCSV .
+// @router /api/workflow_api/export_node_batch_csv [POST]
+func ExportNodeBatchCSV(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req workflow.ExportNodeBatchCSVRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := appworkflow.SVC.ExportNodeBatchCSV(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateRunShareLink .
+// @router /api/workflow_api/run_share_link [POST]
+func CreateRunShareLink(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req workflow.CreateRunShareLinkRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := appworkflow.SVC.CreateRunShareLink(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// AcquireWorkflowEditLock .
+// @router /api/workflow_api/edit_lock/acquire [POST]
+func AcquireWorkflowEditLock(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req workflow.AcquireWorkflowEditLockRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := appworkflow.SVC.AcquireWorkflowEditLock(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ReleaseWorkflowEditLock .
+// @router /api/workflow_api/edit_lock/release [POST]
+func ReleaseWorkflowEditLock(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req workflow.ReleaseWorkflowEditLockRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := appworkflow.SVC.ReleaseWorkflowEditLock(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
 // CancelWorkFlow .
 // @router /api/workflow_api/cancel [POST]
 func CancelWorkFlow(ctx context.Context, c *app.RequestContext) {
@@ -729,6 +829,66 @@ func ValidateTree(ctx context.Context, c *app.RequestContext) {
 	c.JSON(consts.StatusOK, resp)
 }
 
+// GetWorkflowStartForm .
+// @router /api/workflow_api/start_form [POST]
+func GetWorkflowStartForm(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req workflow.GetWorkflowStartFormRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := appworkflow.SVC.GetWorkflowStartForm(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetWorkflowMCPTool .
+// @router /api/workflow_api/mcp_tool [POST]
+func GetWorkflowMCPTool(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req workflow.GetWorkflowMCPToolRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := appworkflow.SVC.GetWorkflowMCPTool(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetWorkflowOpenAPI3Spec .
+// @router /api/workflow_api/openapi3_spec [POST]
+func GetWorkflowOpenAPI3Spec(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req workflow.GetWorkflowOpenAPI3SpecRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := appworkflow.SVC.GetWorkflowOpenAPI3Spec(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
 // GetChatFlowRole .
 // @router /api/workflow_api/chat_flow_role/get [GET]
 func GetChatFlowRole(ctx context.Context, c *app.RequestContext) {
@@ -23,6 +23,7 @@ import (
 	"github.com/cloudwego/hertz/pkg/app"
 
 	"github.com/coze-dev/coze-studio/backend/api/internal/httputil"
+	"github.com/coze-dev/coze-studio/backend/application/base/ctxutil"
 	"github.com/coze-dev/coze-studio/backend/application/user"
 	"github.com/coze-dev/coze-studio/backend/bizpkg/config"
 	"github.com/coze-dev/coze-studio/backend/domain/user/entity"
@@ -101,6 +102,7 @@ func AdminAuthMW() app.HandlerFunc {
 		adminEmails := strings.Split(baseConf.AdminEmails, ",")
 		for _, adminEmail := range adminEmails {
 			if strings.EqualFold(adminEmail, session.UserEmail) {
+				ctxutil.WithAdminCapability(c)
 				ctx.Next(c)
 				return
 			}
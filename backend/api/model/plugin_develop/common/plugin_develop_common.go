@@ -3545,6 +3545,8 @@ func (p *PluginIcon) String() string {
 type GetPlaygroundPluginListData struct {
 	PluginList []*PluginInfoForPlayground `thrift:"plugin_list,1" form:"plugin_list" json:"plugin_list"`
 	Total      int32                      `thrift:"total,2" form:"total" json:"total"`
+	Page       *int32                     `thrift:"page,3,optional" form:"page" json:"page,omitempty" query:"page"`
+	Size       *int32                     `thrift:"size,4,optional" form:"size" json:"size,omitempty" query:"size"`
 }
 
 func NewGetPlaygroundPluginListData() *GetPlaygroundPluginListData {
@@ -3562,9 +3564,37 @@ func (p *GetPlaygroundPluginListData) GetTotal() (v int32) {
 	return p.Total
 }
 
+var GetPlaygroundPluginListData_Page_DEFAULT int32
+
+func (p *GetPlaygroundPluginListData) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetPlaygroundPluginListData_Page_DEFAULT
+	}
+	return *p.Page
+}
+
+var GetPlaygroundPluginListData_Size_DEFAULT int32
+
+func (p *GetPlaygroundPluginListData) GetSize() (v int32) {
+	if !p.IsSetSize() {
+		return GetPlaygroundPluginListData_Size_DEFAULT
+	}
+	return *p.Size
+}
+
 var fieldIDToName_GetPlaygroundPluginListData = map[int16]string{
 	1: "plugin_list",
 	2: "total",
+	3: "page",
+	4: "size",
+}
+
+func (p *GetPlaygroundPluginListData) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *GetPlaygroundPluginListData) IsSetSize() bool {
+	return p.Size != nil
 }
 
 func (p *GetPlaygroundPluginListData) Read(iprot thrift.TProtocol) (err error) {
@@ -3601,6 +3631,22 @@ func (p *GetPlaygroundPluginListData) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3664,6 +3710,28 @@ func (p *GetPlaygroundPluginListData) ReadField2(iprot thrift.TProtocol) error {
 	p.Total = _field
 	return nil
 }
+func (p *GetPlaygroundPluginListData) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *GetPlaygroundPluginListData) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Size = _field
+	return nil
+}
 
 func (p *GetPlaygroundPluginListData) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -3679,6 +3747,14 @@ func (p *GetPlaygroundPluginListData) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3737,6 +3813,44 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *GetPlaygroundPluginListData) writeField3(oprot thrift.TProtocol) (err error) {
+	if !p.IsSetPage() {
+		return nil
+	}
+	if err = oprot.WriteFieldBegin("page", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(*p.Page); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *GetPlaygroundPluginListData) writeField4(oprot thrift.TProtocol) (err error) {
+	if !p.IsSetSize() {
+		return nil
+	}
+	if err = oprot.WriteFieldBegin("size", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(*p.Size); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
 func (p *GetPlaygroundPluginListData) String() string {
 	if p == nil {
@@ -3788,6 +3902,8 @@ type PluginInfoForPlayground struct {
 	VersionTs string `thrift:"version_ts,33" form:"version_ts" json:"version_ts" query:"version_ts"`
 	// version name
 	VersionName string `thrift:"version_name,34" form:"version_name" json:"version_name" query:"version_name"`
+	// quality warning about this workflow's InputParams completeness, e.g. missing descriptions
+	QualityWarning *string `thrift:"quality_warning,35,optional" form:"quality_warning" json:"quality_warning,omitempty" query:"quality_warning"`
 }
 
 func NewPluginInfoForPlayground() *PluginInfoForPlayground {
@@ -3916,6 +4032,15 @@ func (p *PluginInfoForPlayground) GetVersionName() (v string) {
 	return p.VersionName
 }
 
+var PluginInfoForPlayground_QualityWarning_DEFAULT string
+
+func (p *PluginInfoForPlayground) GetQualityWarning() (v string) {
+	if !p.IsSetQualityWarning() {
+		return PluginInfoForPlayground_QualityWarning_DEFAULT
+	}
+	return *p.QualityWarning
+}
+
 var fieldIDToName_PluginInfoForPlayground = map[int16]string{
 	1:  "id",
 	2:  "name",
@@ -3943,6 +4068,7 @@ var fieldIDToName_PluginInfoForPlayground = map[int16]string{
 	32: "project_id",
 	33: "version_ts",
 	34: "version_name",
+	35: "quality_warning",
 }
 
 func (p *PluginInfoForPlayground) IsSetCreator() bool {
@@ -3957,6 +4083,10 @@ func (p *PluginInfoForPlayground) IsSetCommonParams() bool {
 	return p.CommonParams != nil
 }
 
+func (p *PluginInfoForPlayground) IsSetQualityWarning() bool {
+	return p.QualityWarning != nil
+}
+
 func (p *PluginInfoForPlayground) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4183,6 +4313,14 @@ func (p *PluginInfoForPlayground) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 35:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField35(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4534,6 +4672,17 @@ func (p *PluginInfoForPlayground) ReadField34(iprot thrift.TProtocol) error {
 	p.VersionName = _field
 	return nil
 }
+func (p *PluginInfoForPlayground) ReadField35(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.QualityWarning = _field
+	return nil
+}
 
 func (p *PluginInfoForPlayground) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -4645,6 +4794,10 @@ func (p *PluginInfoForPlayground) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 34
 			goto WriteFieldError
 		}
+		if err = p.writeField35(oprot); err != nil {
+			fieldId = 35
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5108,6 +5261,25 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 34 end error: ", p), err)
 }
+func (p *PluginInfoForPlayground) writeField35(oprot thrift.TProtocol) (err error) {
+	if !p.IsSetQualityWarning() {
+		return nil
+	}
+	if err = oprot.WriteFieldBegin("quality_warning", thrift.STRING, 35); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(*p.QualityWarning); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 35 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 35 end error: ", p), err)
+}
 
 func (p *PluginInfoForPlayground) String() string {
 	if p == nil {
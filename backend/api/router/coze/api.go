@@ -417,6 +417,8 @@ func Register(r *server.Hertz) {
 			_workflow_api.POST("/create", append(_createworkflowMw(), coze.CreateWorkflow)...)
 			_workflow_api.POST("/delete", append(_deleteworkflowMw(), coze.DeleteWorkflow)...)
 			_workflow_api.POST("/delete_strategy", append(_getdeletestrategyMw(), coze.GetDeleteStrategy)...)
+			_workflow_api.POST("/edit_lock/acquire", append(_acquireworkfloweditlockMw(), coze.AcquireWorkflowEditLock)...)
+			_workflow_api.POST("/edit_lock/release", append(_releaseworkfloweditlockMw(), coze.ReleaseWorkflowEditLock)...)
 			_workflow_api.POST("/example_workflow_list", append(_getexampleworkflowlistMw(), coze.GetExampleWorkFlowList)...)
 			_workflow_api.GET("/get_node_execute_history", append(_getnodeexecutehistoryMw(), coze.GetNodeExecuteHistory)...)
 			_workflow_api.GET("/get_process", append(_getworkflowprocessMw(), coze.GetWorkFlowProcess)...)
@@ -426,15 +428,21 @@ func Register(r *server.Hertz) {
 			_workflow_api.POST("/list_spans", append(_listrootspansMw(), coze.ListRootSpans)...)
 			_workflow_api.POST("/llm_fc_setting_detail", append(_getllmnodefcsettingdetailMw(), coze.GetLLMNodeFCSettingDetail)...)
 			_workflow_api.POST("/llm_fc_setting_merged", append(_getllmnodefcsettingsmergedMw(), coze.GetLLMNodeFCSettingsMerged)...)
+			_workflow_api.POST("/mcp_tool", append(_getworkflowmcptoolMw(), coze.GetWorkflowMCPTool)...)
 			_workflow_api.POST("/nodeDebug", append(_workflownodedebugv2Mw(), coze.WorkflowNodeDebugV2)...)
 			_workflow_api.POST("/node_panel_search", append(_nodepanelsearchMw(), coze.NodePanelSearch)...)
 			_workflow_api.POST("/node_template_list", append(_nodetemplatelistMw(), coze.NodeTemplateList)...)
 			_workflow_api.POST("/node_type", append(_queryworkflownodetypesMw(), coze.QueryWorkflowNodeTypes)...)
+			_workflow_api.POST("/openapi3_spec", append(_getworkflowopenapi3specMw(), coze.GetWorkflowOpenAPI3Spec)...)
 			_workflow_api.POST("/publish", append(_publishworkflowMw(), coze.PublishWorkflow)...)
 			_workflow_api.POST("/released_workflows", append(_getreleasedworkflowsMw(), coze.GetReleasedWorkflows)...)
+			_workflow_api.POST("/run_share_link", append(_createrunsharelinkMw(), coze.CreateRunShareLink)...)
 			_workflow_api.POST("/save", append(_saveworkflowMw(), coze.SaveWorkflow)...)
 			_workflow_api.POST("/sign_image_url", append(_signimageurlMw(), coze.SignImageURL)...)
+			_workflow_api.POST("/start_form", append(_getworkflowstartformMw(), coze.GetWorkflowStartForm)...)
 			_workflow_api.POST("/test_resume", append(_workflowtestresumeMw(), coze.WorkFlowTestResume)...)
+			_workflow_api.POST("/inspect_variables", append(_inspectvariablesMw(), coze.InspectVariables)...)
+			_workflow_api.POST("/export_node_batch_csv", append(_exportnodebatchcsvMw(), coze.ExportNodeBatchCSV)...)
 			_workflow_api.POST("/test_run", append(_workflowtestrunMw(), coze.WorkFlowTestRun)...)
 			_workflow_api.POST("/update_meta", append(_updateworkflowmetaMw(), coze.UpdateWorkflowMeta)...)
 			_workflow_api.POST("/validate_tree", append(_validatetreeMw(), coze.ValidateTree)...)
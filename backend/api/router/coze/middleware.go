@@ -650,6 +650,16 @@ func _getdeletestrategyMw() []app.HandlerFunc {
 	return nil
 }
 
+func _acquireworkfloweditlockMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _releaseworkfloweditlockMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
 func _getnodeexecutehistoryMw() []app.HandlerFunc {
 	// your code...
 	return nil
@@ -715,6 +725,11 @@ func _getreleasedworkflowsMw() []app.HandlerFunc {
 	return nil
 }
 
+func _createrunsharelinkMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
 func _saveworkflowMw() []app.HandlerFunc {
 	// your code...
 	return nil
@@ -725,11 +740,36 @@ func _signimageurlMw() []app.HandlerFunc {
 	return nil
 }
 
+func _getworkflowstartformMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getworkflowmcptoolMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getworkflowopenapi3specMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
 func _workflowtestresumeMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
 
+func _inspectvariablesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportnodebatchcsvMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
 func _workflowtestrunMw() []app.HandlerFunc {
 	// your code...
 	return nil
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ctxutil
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-studio/backend/pkg/ctxcache"
+	"github.com/coze-dev/coze-studio/backend/types/consts"
+)
+
+// WithAdminCapability marks the request carried by ctx as made by a caller with admin
+// capability, e.g. internal admin/support tooling authenticated out of band from normal
+// user sessions. It must be set before ctxcache-backed reads of it, so callers should set
+// it as early as possible in the request lifecycle (typically in auth middleware).
+func WithAdminCapability(ctx context.Context) {
+	ctxcache.Store(ctx, consts.AdminCapKeyInCtx, true)
+}
+
+// HasAdminCapability reports whether the request carried by ctx was marked via
+// WithAdminCapability.
+func HasAdminCapability(ctx context.Context) bool {
+	hasAdminCap, ok := ctxcache.Get[bool](ctx, consts.AdminCapKeyInCtx)
+	return ok && hasAdminCap
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/coze-dev/coze-studio/backend/application/base/ctxutil"
+	"github.com/coze-dev/coze-studio/backend/crossdomain/plugin/consts"
+	"github.com/coze-dev/coze-studio/backend/crossdomain/plugin/model"
+	"github.com/coze-dev/coze-studio/backend/domain/permission"
+	pluginConf "github.com/coze-dev/coze-studio/backend/domain/plugin/conf"
+	"github.com/coze-dev/coze-studio/backend/pkg/errorx"
+	"github.com/coze-dev/coze-studio/backend/pkg/lang/conv"
+	"github.com/coze-dev/coze-studio/backend/types/errno"
+)
+
+// TestInvokeToolRequest carries the inputs needed to test-invoke a single tool with sample
+// arguments, without wiring it into a workflow first.
+type TestInvokeToolRequest struct {
+	SpaceID         int64
+	PluginID        int64
+	ToolID          int64
+	ArgumentsInJson string
+}
+
+// TestInvokeToolResponse carries the raw, unprocessed response of a test-invoked tool.
+type TestInvokeToolResponse struct {
+	RawResp string
+}
+
+// TestInvokeTool lets plugin developers invoke a tool with sample arguments and see the raw
+// response, without building a workflow around it first. It validates the arguments against the
+// tool's operation schema (as loaded into the plugin product registry), enforces the same
+// space/plugin permission and rate limit checks a real invocation would go through, then performs
+// the call via the normal tool execution path.
+func (p *PluginApplicationService) TestInvokeTool(ctx context.Context, req *TestInvokeToolRequest) (resp *TestInvokeToolResponse, err error) {
+	uid := ctxutil.GetUIDFromCtx(ctx)
+	if uid == nil {
+		return nil, errorx.New(errno.ErrPluginPermissionCode, errorx.KV(errno.PluginMsgKey, "session is required"))
+	}
+
+	if err = checkUserSpace(ctx, *uid, req.SpaceID); err != nil {
+		return nil, errorx.Wrapf(err, "checkUserSpace failed, spaceID=%d", req.SpaceID)
+	}
+
+	toolInfo, exist := pluginConf.GetToolProduct(req.ToolID)
+	if !exist || toolInfo.Info.PluginID != req.PluginID {
+		return nil, errorx.New(errno.ErrPluginInvalidParamCode,
+			errorx.KVf(errno.PluginMsgKey, "tool '%d' not found under plugin '%d'", req.ToolID, req.PluginID))
+	}
+
+	if toolInfo.Info.Operation != nil {
+		if err = validateToolArguments(toolInfo.Info.Operation, req.ArgumentsInJson); err != nil {
+			return nil, errorx.New(errno.ErrPluginInvalidParamCode, errorx.KV(errno.PluginMsgKey, err.Error()))
+		}
+	}
+
+	if err = pluginConf.CheckToolRateLimit(req.ToolID); err != nil {
+		return nil, err
+	}
+
+	res, err := p.DomainSVC.ExecuteTool(ctx, &model.ExecuteToolRequest{
+		UserID:          conv.Int64ToStr(*uid),
+		PluginID:        req.PluginID,
+		ToolID:          req.ToolID,
+		ExecScene:       consts.ExecSceneOfToolDebug,
+		ArgumentsInJson: req.ArgumentsInJson,
+	})
+	if err != nil {
+		return nil, errorx.Wrapf(err, "ExecuteTool failed, toolID=%d", req.ToolID)
+	}
+
+	return &TestInvokeToolResponse{RawResp: res.RawResp}, nil
+}
+
+// validateToolArguments checks argumentsInJSON is well-formed JSON that satisfies the request
+// body schema of op, the tool's loaded OpenAPI operation.
+func validateToolArguments(op *model.Openapi3Operation, argumentsInJSON string) error {
+	var args map[string]any
+	if err := sonic.UnmarshalString(argumentsInJSON, &args); err != nil {
+		return fmt.Errorf("arguments is not valid JSON: %v", err)
+	}
+
+	_, schemaRef := op.GetReqBodySchema()
+	if schemaRef == nil || schemaRef.Value == nil {
+		return nil
+	}
+
+	if err := schemaRef.Value.VisitJSON(args); err != nil {
+		return fmt.Errorf("arguments do not match the tool's operation schema: %v", err)
+	}
+
+	return nil
+}
+
+func checkUserSpace(ctx context.Context, uid int64, spaceID int64) error {
+	result, err := permission.DefaultSVC().CheckAuthz(ctx, &permission.CheckAuthzData{
+		ResourceIdentifier: []*permission.ResourceIdentifier{
+			{
+				Type:   permission.ResourceTypeWorkspace,
+				ID:     []int64{spaceID},
+				Action: permission.ActionRead,
+			},
+		},
+		OperatorID: uid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check workspace permission: %w", err)
+	}
+
+	if result.Decision != permission.Allow {
+		return fmt.Errorf("user %d does not have access to space %d", uid, spaceID)
+	}
+
+	return nil
+}
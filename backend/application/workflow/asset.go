@@ -0,0 +1,71 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/coze-dev/coze-studio/backend/application/base/ctxutil"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+)
+
+// hasKnownUploadPrefix reports whether uri starts with one of the object key prefixes handed out
+// by GetWorkflowUploadAuthToken, guarding DeleteWorkflowAsset against deleting arbitrary TOS
+// objects a caller didn't actually upload through this workflow.
+func hasKnownUploadPrefix(uri string) bool {
+	for _, sceneConfig := range sceneToUploadConfigMap {
+		if strings.HasPrefix(uri, sceneConfig.prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteWorkflowAsset removes the TOS object at uri, after checking the caller has access to
+// workflowID's space and that the asset isn't still referenced by the workflow's current draft
+// schema or its chat flow role (avatar/background images), so an in-use asset can't be deleted
+// out from under a running workflow.
+func (w *ApplicationService) DeleteWorkflowAsset(ctx context.Context, workflowID int64, uri string) (err error) {
+	if !hasKnownUploadPrefix(uri) {
+		return fmt.Errorf("uri %s does not belong to a known upload prefix", uri)
+	}
+
+	wf, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{ID: workflowID})
+	if err != nil {
+		return err
+	}
+
+	if err = checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), wf.Meta.SpaceID); err != nil {
+		return err
+	}
+
+	if strings.Contains(wf.Canvas, uri) {
+		return fmt.Errorf("asset %s is still referenced by workflow %d's schema", uri, workflowID)
+	}
+
+	role, err := GetWorkflowDomainSVC().GetChatFlowRole(ctx, workflowID, "")
+	if err != nil {
+		return err
+	}
+	if role != nil && (role.AvatarUri == uri || strings.Contains(role.BackgroundImageInfo, uri)) {
+		return fmt.Errorf("asset %s is still referenced by workflow %d's chat flow role", uri, workflowID)
+	}
+
+	return w.ImageX.DeleteObject(ctx, uri)
+}
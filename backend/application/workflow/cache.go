@@ -0,0 +1,44 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-studio/backend/application/base/ctxutil"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+)
+
+// SetWorkflowCacheConfig opts workflowID in or out of the OpenAPIRun result cache, and sets how
+// long a cached result stays valid. It is meant for workflows that are pure functions of their
+// input: no LLM randomness, no side effects. Streaming runs always bypass the cache regardless
+// of this setting.
+func (w *ApplicationService) SetWorkflowCacheConfig(ctx context.Context, workflowID int64, enabled bool, ttlSeconds int32) (err error) {
+	meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{ID: workflowID, MetaOnly: true})
+	if err != nil {
+		return err
+	}
+
+	if err = checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), meta.SpaceID); err != nil {
+		return err
+	}
+
+	return GetWorkflowDomainSVC().UpdateMeta(ctx, workflowID, &vo.MetaUpdate{
+		CacheEnabled:    &enabled,
+		CacheTTLSeconds: &ttlSeconds,
+	})
+}
@@ -580,17 +580,17 @@ func (w *ApplicationService) OpenAPIChatFlowRun(ctx context.Context, req *workfl
 		return nil, errors.New("project_id and bot_id cannot be set at the same time")
 	}
 
+	meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+		ID:       workflowID,
+		MetaOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	if isDebug {
 		locator = workflowModel.FromDraft
 	} else {
-		meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
-			ID:       workflowID,
-			MetaOnly: true,
-		})
-		if err != nil {
-			return nil, err
-		}
-
 		if meta.LatestPublishedVersion == nil {
 			return nil, vo.NewError(errno.ErrWorkflowNotPublished)
 		}
@@ -603,6 +603,10 @@ func (w *ApplicationService) OpenAPIChatFlowRun(ctx context.Context, req *workfl
 		}
 	}
 
+	if err := checkAndIncrementExecutionQuota(meta.SpaceID); err != nil {
+		return nil, err
+	}
+
 	if req.IsSetConversationID() && !req.IsSetBotID() {
 		conversationID = mustParseInt64(req.GetConversationID())
 		cInfo, err := crossconversation.DefaultSVC().GetByID(ctx, conversationID)
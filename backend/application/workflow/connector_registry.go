@@ -0,0 +1,128 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+	"github.com/coze-dev/coze-studio/backend/pkg/errorx"
+	"github.com/coze-dev/coze-studio/backend/types/consts"
+	"github.com/coze-dev/coze-studio/backend/types/errno"
+)
+
+// ConnectorBehavior describes how OpenAPIRun and OpenAPIStreamRun treat requests asserting a
+// given connector ID, beyond the default of always substituting the API key's own connector.
+type ConnectorBehavior struct {
+	// HonorConnectorID allows a request to assert this connector's ID (and, by extension, a
+	// caller-supplied UID via the "user_id" ext field) instead of it being overwritten with the
+	// API key's own connector, mirroring the long-standing consts.WebSDKConnectorID special case.
+	HonorConnectorID bool
+	// RateLimitQPS caps requests per second attributed to this connector, across all workflows.
+	// 0 means no connector-specific rate limit is applied.
+	RateLimitQPS int
+	// AllowedWorkflowIDs, if non-empty, restricts this connector to running only the listed
+	// workflows; a request for any other workflow ID is rejected.
+	AllowedWorkflowIDs map[int64]bool
+}
+
+var (
+	connectorRegistryMu sync.RWMutex
+	connectorRegistry   = map[int64]*ConnectorBehavior{
+		consts.WebSDKConnectorID: {HonorConnectorID: true},
+	}
+)
+
+// RegisterConnector registers the behavior for connectorID, consulted by OpenAPIRun and
+// OpenAPIStreamRun. Connectors that are never registered keep today's default behavior: the
+// caller's own API-key connector is always used, with no rate limit or workflow allowlist.
+func RegisterConnector(connectorID int64, behavior *ConnectorBehavior) {
+	connectorRegistryMu.Lock()
+	defer connectorRegistryMu.Unlock()
+	connectorRegistry[connectorID] = behavior
+}
+
+// defaultConnectorBehavior is returned for any connectorID with nothing registered.
+var defaultConnectorBehavior = &ConnectorBehavior{}
+
+func getConnectorBehavior(connectorID int64) *ConnectorBehavior {
+	connectorRegistryMu.RLock()
+	defer connectorRegistryMu.RUnlock()
+	if b, ok := connectorRegistry[connectorID]; ok {
+		return b
+	}
+	return defaultConnectorBehavior
+}
+
+// checkConnectorAllowsWorkflow rejects the run if behavior restricts connectorID to a specific
+// workflow allowlist that does not include workflowID. A nil/empty allowlist permits everything.
+func checkConnectorAllowsWorkflow(connectorID int64, behavior *ConnectorBehavior, workflowID int64) error {
+	if len(behavior.AllowedWorkflowIDs) == 0 || behavior.AllowedWorkflowIDs[workflowID] {
+		return nil
+	}
+
+	return vo.WrapError(errno.ErrConnectorWorkflowNotAllowed,
+		fmt.Errorf("connector %d is not allowed to run workflow %d", connectorID, workflowID),
+		errorx.KV("connector_id", fmt.Sprintf("%d", connectorID)), errorx.KV("id", fmt.Sprintf("%d", workflowID)))
+}
+
+type connectorRateWindow struct {
+	mu    sync.Mutex
+	start time.Time
+	count int
+}
+
+var (
+	connectorRateWindowsMu sync.Mutex
+	connectorRateWindows   = map[int64]*connectorRateWindow{}
+)
+
+// checkConnectorRateLimit enforces behavior.RateLimitQPS for connectorID using a simple
+// one-second fixed window counter. A RateLimitQPS of 0 disables the check.
+func checkConnectorRateLimit(connectorID int64, behavior *ConnectorBehavior) error {
+	if behavior.RateLimitQPS <= 0 {
+		return nil
+	}
+
+	connectorRateWindowsMu.Lock()
+	w, ok := connectorRateWindows[connectorID]
+	if !ok {
+		w = &connectorRateWindow{}
+		connectorRateWindows[connectorID] = w
+	}
+	connectorRateWindowsMu.Unlock()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(w.start) >= time.Second {
+		w.start = now
+		w.count = 0
+	}
+	w.count++
+
+	if w.count > behavior.RateLimitQPS {
+		return vo.WrapError(errno.ErrConnectorRateLimited,
+			fmt.Errorf("connector %d exceeded its rate limit of %d requests/s", connectorID, behavior.RateLimitQPS),
+			errorx.KV("connector_id", fmt.Sprintf("%d", connectorID)))
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-studio/backend/types/consts"
+)
+
+func TestGetConnectorBehavior_Default(t *testing.T) {
+	b := getConnectorBehavior(123456789)
+	assert.False(t, b.HonorConnectorID)
+	assert.Equal(t, 0, b.RateLimitQPS)
+	assert.Empty(t, b.AllowedWorkflowIDs)
+}
+
+func TestGetConnectorBehavior_WebSDKHonored(t *testing.T) {
+	b := getConnectorBehavior(consts.WebSDKConnectorID)
+	assert.True(t, b.HonorConnectorID)
+}
+
+func TestRegisterConnector(t *testing.T) {
+	const connectorID = int64(-1001)
+	RegisterConnector(connectorID, &ConnectorBehavior{
+		HonorConnectorID:   true,
+		AllowedWorkflowIDs: map[int64]bool{42: true},
+	})
+
+	b := getConnectorBehavior(connectorID)
+	assert.True(t, b.HonorConnectorID)
+	assert.NoError(t, checkConnectorAllowsWorkflow(connectorID, b, 42))
+	assert.Error(t, checkConnectorAllowsWorkflow(connectorID, b, 43))
+}
+
+func TestCheckConnectorRateLimit(t *testing.T) {
+	const connectorID = int64(-1002)
+	behavior := &ConnectorBehavior{RateLimitQPS: 2}
+
+	assert.NoError(t, checkConnectorRateLimit(connectorID, behavior))
+	assert.NoError(t, checkConnectorRateLimit(connectorID, behavior))
+	assert.Error(t, checkConnectorRateLimit(connectorID, behavior))
+}
+
+func TestCheckConnectorRateLimit_Disabled(t *testing.T) {
+	const connectorID = int64(-1003)
+	behavior := &ConnectorBehavior{}
+
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, checkConnectorRateLimit(connectorID, behavior))
+	}
+}
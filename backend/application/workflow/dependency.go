@@ -0,0 +1,69 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-studio/backend/application/base/ctxutil"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+)
+
+// FindWorkflowsUsingPlugin returns the draft workflows in spaceID that depend on pluginID,
+// directly or through a sub-workflow or LLM tool call, so an operator can gauge the blast
+// radius before deprecating or modifying the plugin.
+func (w *ApplicationService) FindWorkflowsUsingPlugin(ctx context.Context, spaceID, pluginID int64) ([]*vo.Meta, error) {
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), spaceID); err != nil {
+		return nil, err
+	}
+
+	return GetWorkflowDomainSVC().FindWorkflowsUsingPlugin(ctx, spaceID, pluginID)
+}
+
+// defaultDependencyScanPageSize is used by FindWorkflowsUsingKnowledge when the caller doesn't
+// specify a page size, to keep each scanned page's GetWorkflowDependenceResource fan-out bounded.
+const defaultDependencyScanPageSize = int32(50)
+
+// FindWorkflowsUsingKnowledge returns one page of the draft workflows in spaceID that depend on
+// knowledgeID, directly or through a sub-workflow or LLM tool call, plus the total number of
+// draft workflows scanned in spaceID, so a knowledge owner can page through a large space before
+// deleting or restructuring the dataset.
+func (w *ApplicationService) FindWorkflowsUsingKnowledge(ctx context.Context, spaceID, knowledgeID int64, pageNum, pageSize int32) ([]*vo.Meta, int64, error) {
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), spaceID); err != nil {
+		return nil, 0, err
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultDependencyScanPageSize
+	}
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+
+	return GetWorkflowDomainSVC().FindWorkflowsUsingKnowledge(ctx, spaceID, knowledgeID, &vo.Page{Page: pageNum, Size: pageSize})
+}
+
+// FindWorkflowsUsingDatabase returns the draft workflows in spaceID with a node that references
+// databaseID, one entry per matching node, so an owner can tell whether a table schema change
+// would break them before making it.
+func (w *ApplicationService) FindWorkflowsUsingDatabase(ctx context.Context, spaceID, databaseID int64) ([]*vo.DatabaseUsage, error) {
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), spaceID); err != nil {
+		return nil, err
+	}
+
+	return GetWorkflowDomainSVC().FindWorkflowsUsingDatabase(ctx, spaceID, databaseID)
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-studio/backend/application/base/ctxutil"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+)
+
+// GetExperimentVariantStats aggregates workflowID's executions created within [from, to) by the
+// experiment/variant label callers passed via OpenAPIRun's Ext["experiment_variant"], so two
+// variants of a workflow can be compared on success rate and average token usage.
+func (w *ApplicationService) GetExperimentVariantStats(ctx context.Context, workflowID int64, from, to time.Time) ([]*entity.VariantStats, error) {
+	meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{ID: workflowID, MetaOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), meta.SpaceID); err != nil {
+		return nil, err
+	}
+
+	return GetWorkflowDomainSVC().GetVariantStats(ctx, workflowID, from, to)
+}
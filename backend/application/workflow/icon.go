@@ -0,0 +1,129 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/coze-dev/coze-studio/backend/infra/imagex"
+	"github.com/coze-dev/coze-studio/backend/pkg/logs"
+)
+
+// batchSignImageURLConcurrency bounds the fan-out in BatchSignImageURL, mirroring
+// refreshNodeIconURLCache's use of errgroup for resolving many object URLs at once.
+const batchSignImageURLConcurrency = 10
+
+const (
+	// signedImageURLTTL is the validity window requested from ImageX for every signed URL this
+	// process hands out, and therefore how long a cache entry may be reused for.
+	signedImageURLTTL = time.Hour
+	// signedImageURLRefreshMargin re-signs a cache entry once it's this close to expiry, so
+	// callers never get handed a URL that's about to stop working.
+	signedImageURLRefreshMargin = 5 * time.Minute
+	// signedImageURLCacheMaxSize bounds signedImageURLCache so a long-lived process signing many
+	// distinct URIs over time doesn't grow the cache without limit. Once full, expired entries are
+	// swept to make room; an entry that still can't fit is simply not cached.
+	signedImageURLCacheMaxSize = 10000
+)
+
+var (
+	signedImageURLCache   = make(map[string]signedImageURLEntry)
+	signedImageURLCacheMu sync.Mutex
+)
+
+type signedImageURLEntry struct {
+	url       string
+	expiresAt time.Time
+}
+
+// resolveImageURL returns the signed URL for uri, serving it from signedImageURLCache if a
+// still-fresh entry exists and re-signing via ImageX.GetResourceURL otherwise.
+func (w *ApplicationService) resolveImageURL(ctx context.Context, uri string) (string, error) {
+	now := time.Now()
+
+	signedImageURLCacheMu.Lock()
+	entry, ok := signedImageURLCache[uri]
+	signedImageURLCacheMu.Unlock()
+	if ok && now.Before(entry.expiresAt.Add(-signedImageURLRefreshMargin)) {
+		return entry.url, nil
+	}
+
+	resourceURL, err := w.ImageX.GetResourceURL(ctx, uri, imagex.WithResourceExpire(int(signedImageURLTTL.Seconds())))
+	if err != nil {
+		return "", err
+	}
+
+	signedImageURLCacheMu.Lock()
+	if len(signedImageURLCache) >= signedImageURLCacheMaxSize {
+		evictExpiredSignedImageURLs(now)
+	}
+	if len(signedImageURLCache) < signedImageURLCacheMaxSize {
+		signedImageURLCache[uri] = signedImageURLEntry{
+			url:       resourceURL.URL,
+			expiresAt: now.Add(signedImageURLTTL),
+		}
+	}
+	signedImageURLCacheMu.Unlock()
+
+	return resourceURL.URL, nil
+}
+
+// evictExpiredSignedImageURLs drops every signedImageURLCache entry that has already passed its
+// expiresAt. Callers must hold signedImageURLCacheMu.
+func evictExpiredSignedImageURLs(now time.Time) {
+	for uri, entry := range signedImageURLCache {
+		if now.After(entry.expiresAt) {
+			delete(signedImageURLCache, uri)
+		}
+	}
+}
+
+// BatchSignImageURL resolves uris to their signed URLs concurrently, the same way SignImageURL
+// resolves one, so listing views with many icons don't pay for one round trip per icon. A URI
+// that fails to resolve is recorded in errs rather than failing the whole batch; urls only
+// contains entries for URIs that resolved successfully.
+func (w *ApplicationService) BatchSignImageURL(ctx context.Context, uris []string) (urls map[string]string, errs map[string]error) {
+	urls = make(map[string]string, len(uris))
+	errs = make(map[string]error)
+	var mu sync.Mutex
+
+	eg, gCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(batchSignImageURLConcurrency)
+	for _, uri := range uris {
+		uri := uri
+		eg.Go(func() error {
+			url, err := w.resolveImageURL(gCtx, uri)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				logs.CtxWarnf(ctx, "[BatchSignImageURL] failed to resolve uri=%s, err=%v", uri, err)
+				errs[uri] = err
+				return nil
+			}
+			urls[uri] = url
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	return urls, errs
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvictExpiredSignedImageURLs(t *testing.T) {
+	signedImageURLCacheMu.Lock()
+	defer func() {
+		signedImageURLCache = make(map[string]signedImageURLEntry)
+		signedImageURLCacheMu.Unlock()
+	}()
+
+	now := time.Now()
+	signedImageURLCache = map[string]signedImageURLEntry{
+		"expired": {url: "http://expired", expiresAt: now.Add(-time.Minute)},
+		"fresh":   {url: "http://fresh", expiresAt: now.Add(time.Hour)},
+	}
+
+	evictExpiredSignedImageURLs(now)
+
+	_, expiredStillPresent := signedImageURLCache["expired"]
+	assert.False(t, expiredStillPresent)
+	_, freshStillPresent := signedImageURLCache["fresh"]
+	assert.True(t, freshStillPresent)
+}
+
+func TestEvictExpiredSignedImageURLs_MakesRoomUnderFullExpiredCache(t *testing.T) {
+	signedImageURLCacheMu.Lock()
+	defer func() {
+		signedImageURLCache = make(map[string]signedImageURLEntry)
+		signedImageURLCacheMu.Unlock()
+	}()
+
+	now := time.Now()
+	signedImageURLCache = make(map[string]signedImageURLEntry, signedImageURLCacheMaxSize)
+	for i := 0; i < signedImageURLCacheMaxSize; i++ {
+		signedImageURLCache[string(rune(i))] = signedImageURLEntry{url: "http://x", expiresAt: now.Add(-time.Minute)}
+	}
+
+	evictExpiredSignedImageURLs(now)
+
+	assert.Empty(t, signedImageURLCache)
+}
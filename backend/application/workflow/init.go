@@ -94,6 +94,10 @@ func InitService(_ context.Context, components *ServiceComponents) (*Application
 
 	workflow.SetRepository(workflowRepo)
 
+	for spaceID, limit := range cfg.GetSpaceExecutionQuotas() {
+		SetSpaceExecutionQuota(spaceID, limit)
+	}
+
 	workflowDomainSVC := service.NewWorkflowService(workflowRepo)
 	wrapPlugin.SetOSS(components.Tos)
 
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+	"github.com/coze-dev/coze-studio/backend/pkg/errorx"
+	"github.com/coze-dev/coze-studio/backend/types/errno"
+)
+
+// ModerationResult is the outcome of running a piece of text through a ModerationHook.
+type ModerationResult struct {
+	// Flagged is true if the content violates policy and must be blocked or replaced.
+	Flagged bool
+	// Reason explains why the content was flagged, surfaced in the moderation error event.
+	Reason string
+	// Replacement, if set, is substituted for the original content instead of blocking the
+	// output outright.
+	Replacement *string
+}
+
+// ModerationHook moderates workflow output content before it reaches the caller, so
+// deployments in regulated contexts can plug in their own content-safety provider.
+type ModerationHook interface {
+	// Moderate inspects text produced by a workflow run, such as OpenAPIRun's final output or
+	// a single streamed Answer message in OpenAPIStreamRun.
+	Moderate(ctx context.Context, text string) (*ModerationResult, error)
+}
+
+var (
+	moderationHookMu sync.RWMutex
+	moderationHook   ModerationHook
+)
+
+// SetModerationHook installs hook as the moderation provider consulted by OpenAPIRun and
+// OpenAPIStreamRun. Passing nil disables moderation, which is also the default.
+func SetModerationHook(hook ModerationHook) {
+	moderationHookMu.Lock()
+	defer moderationHookMu.Unlock()
+	moderationHook = hook
+}
+
+func getModerationHook() ModerationHook {
+	moderationHookMu.RLock()
+	defer moderationHookMu.RUnlock()
+	return moderationHook
+}
+
+// moderateText runs text through the installed ModerationHook, if any, and returns the text to
+// use in its place. If the hook flags the content with no Replacement, it returns a
+// vo.WorkflowError wrapping errno.ErrContentModerationBlocked instead.
+func moderateText(ctx context.Context, text string) (string, error) {
+	hook := getModerationHook()
+	if hook == nil {
+		return text, nil
+	}
+
+	result, err := hook.Moderate(ctx, text)
+	if err != nil {
+		return "", err
+	}
+	if result == nil || !result.Flagged {
+		return text, nil
+	}
+
+	if result.Replacement != nil {
+		return *result.Replacement, nil
+	}
+
+	return "", vo.WrapError(errno.ErrContentModerationBlocked,
+		fmt.Errorf("content blocked by moderation: %s", result.Reason),
+		errorx.KV("reason", result.Reason))
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeModerationHook struct {
+	result *ModerationResult
+	err    error
+}
+
+func (f *fakeModerationHook) Moderate(_ context.Context, _ string) (*ModerationResult, error) {
+	return f.result, f.err
+}
+
+func TestModerateText_NoHookInstalled(t *testing.T) {
+	SetModerationHook(nil)
+
+	text, err := moderateText(context.Background(), "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", text)
+}
+
+func TestModerateText_NotFlagged(t *testing.T) {
+	SetModerationHook(&fakeModerationHook{result: &ModerationResult{Flagged: false}})
+	defer SetModerationHook(nil)
+
+	text, err := moderateText(context.Background(), "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", text)
+}
+
+func TestModerateText_Replaced(t *testing.T) {
+	replacement := "[redacted]"
+	SetModerationHook(&fakeModerationHook{result: &ModerationResult{Flagged: true, Replacement: &replacement}})
+	defer SetModerationHook(nil)
+
+	text, err := moderateText(context.Background(), "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, replacement, text)
+}
+
+func TestModerateText_Blocked(t *testing.T) {
+	SetModerationHook(&fakeModerationHook{result: &ModerationResult{Flagged: true, Reason: "unsafe"}})
+	defer SetModerationHook(nil)
+
+	_, err := moderateText(context.Background(), "hello")
+	assert.Error(t, err)
+}
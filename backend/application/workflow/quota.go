@@ -0,0 +1,149 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+	"github.com/coze-dev/coze-studio/backend/pkg/errorx"
+	"github.com/coze-dev/coze-studio/backend/types/errno"
+)
+
+// SpaceExecutionQuota reports a space's daily workflow execution usage, as returned by
+// GetSpaceExecutionQuota.
+type SpaceExecutionQuota struct {
+	// Limit is the configured daily execution cap for the space. 0 means no limit is set.
+	Limit int64
+	// Used is the number of executions counted against today's window so far.
+	Used int64
+	// Remaining is Limit-Used, floored at 0. Always 0 when Limit is 0 (no limit configured).
+	Remaining int64
+}
+
+var (
+	executionQuotaLimitsMu sync.RWMutex
+	executionQuotaLimits   = map[int64]int64{}
+)
+
+// SetSpaceExecutionQuota configures the daily workflow execution limit for spaceID, consulted
+// by AsyncExecute/SyncExecute/StreamExecute entry points. A limit of 0 removes the cap.
+func SetSpaceExecutionQuota(spaceID int64, limit int64) {
+	executionQuotaLimitsMu.Lock()
+	defer executionQuotaLimitsMu.Unlock()
+	if limit <= 0 {
+		delete(executionQuotaLimits, spaceID)
+		return
+	}
+	executionQuotaLimits[spaceID] = limit
+}
+
+func getSpaceExecutionQuotaLimit(spaceID int64) int64 {
+	executionQuotaLimitsMu.RLock()
+	defer executionQuotaLimitsMu.RUnlock()
+	return executionQuotaLimits[spaceID]
+}
+
+// executionQuotaWindow counts executions for a space within the current day, resetting when
+// the day rolls over. Mirrors checkConnectorRateLimit's fixed-window counter in
+// connector_registry.go, just with a day-long window instead of a second-long one.
+type executionQuotaWindow struct {
+	mu    sync.Mutex
+	day   string
+	count int64
+}
+
+var (
+	executionQuotaWindowsMu sync.Mutex
+	executionQuotaWindows   = map[int64]*executionQuotaWindow{}
+)
+
+func currentQuotaDay() string {
+	return time.Now().UTC().Format("2006-01-02")
+}
+
+// checkAndIncrementExecutionQuota counts one execution against spaceID's daily quota, rejecting
+// with a vo.WorkflowError wrapping errno.ErrSpaceExecutionQuotaExceeded once the configured
+// limit (if any) is reached. Spaces with no configured limit are never rejected.
+func checkAndIncrementExecutionQuota(spaceID int64) error {
+	limit := getSpaceExecutionQuotaLimit(spaceID)
+	if limit <= 0 {
+		return nil
+	}
+
+	executionQuotaWindowsMu.Lock()
+	w, ok := executionQuotaWindows[spaceID]
+	if !ok {
+		w = &executionQuotaWindow{}
+		executionQuotaWindows[spaceID] = w
+	}
+	executionQuotaWindowsMu.Unlock()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	today := currentQuotaDay()
+	if w.day != today {
+		w.day = today
+		w.count = 0
+	}
+
+	if w.count >= limit {
+		return vo.WrapError(errno.ErrSpaceExecutionQuotaExceeded,
+			fmt.Errorf("space %d has reached its daily execution quota of %d", spaceID, limit),
+			errorx.KV("space_id", fmt.Sprintf("%d", spaceID)), errorx.KV("limit", fmt.Sprintf("%d", limit)))
+	}
+
+	w.count++
+	return nil
+}
+
+// GetSpaceExecutionQuota reports spaceID's configured daily execution limit and today's usage
+// so far.
+func (w *ApplicationService) GetSpaceExecutionQuota(_ context.Context, spaceID int64) (*SpaceExecutionQuota, error) {
+	limit := getSpaceExecutionQuotaLimit(spaceID)
+
+	executionQuotaWindowsMu.Lock()
+	win, ok := executionQuotaWindows[spaceID]
+	executionQuotaWindowsMu.Unlock()
+
+	var used int64
+	if ok {
+		win.mu.Lock()
+		if win.day == currentQuotaDay() {
+			used = win.count
+		}
+		win.mu.Unlock()
+	}
+
+	remaining := int64(0)
+	if limit > 0 {
+		remaining = limit - used
+		if remaining < 0 {
+			remaining = 0
+		}
+	}
+
+	return &SpaceExecutionQuota{
+		Limit:     limit,
+		Used:      used,
+		Remaining: remaining,
+	}, nil
+}
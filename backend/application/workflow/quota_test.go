@@ -0,0 +1,65 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckAndIncrementExecutionQuota_NoLimitConfigured(t *testing.T) {
+	SetSpaceExecutionQuota(101, 0)
+
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, checkAndIncrementExecutionQuota(101))
+	}
+}
+
+func TestCheckAndIncrementExecutionQuota_RejectsOnceLimitReached(t *testing.T) {
+	SetSpaceExecutionQuota(102, 2)
+	defer SetSpaceExecutionQuota(102, 0)
+
+	assert.NoError(t, checkAndIncrementExecutionQuota(102))
+	assert.NoError(t, checkAndIncrementExecutionQuota(102))
+	assert.Error(t, checkAndIncrementExecutionQuota(102))
+}
+
+func TestGetSpaceExecutionQuota_ReportsUsageAndRemaining(t *testing.T) {
+	SetSpaceExecutionQuota(103, 3)
+	defer SetSpaceExecutionQuota(103, 0)
+
+	assert.NoError(t, checkAndIncrementExecutionQuota(103))
+
+	w := &ApplicationService{}
+	quota, err := w.GetSpaceExecutionQuota(context.Background(), 103)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), quota.Limit)
+	assert.Equal(t, int64(1), quota.Used)
+	assert.Equal(t, int64(2), quota.Remaining)
+}
+
+func TestGetSpaceExecutionQuota_NoLimitConfigured(t *testing.T) {
+	SetSpaceExecutionQuota(104, 0)
+
+	w := &ApplicationService{}
+	quota, err := w.GetSpaceExecutionQuota(context.Background(), 104)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), quota.Limit)
+	assert.Equal(t, int64(0), quota.Remaining)
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/coze-dev/coze-studio/backend/api/model/workflow"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+	"github.com/coze-dev/coze-studio/backend/pkg/logs"
+)
+
+// batchValidateTreeConcurrency bounds the fan-out in BatchValidateTree, mirroring
+// BatchSignImageURL's use of errgroup for resolving many items at once.
+const batchValidateTreeConcurrency = 10
+
+// BatchValidateTreeEntry is one workflow to validate in a BatchValidateTree call.
+type BatchValidateTreeEntry struct {
+	WorkflowID int64
+	Schema     string
+}
+
+// BatchValidateTree runs ValidateTree over entries concurrently, so a bulk-publish flow doesn't
+// pay for one round trip per workflow. A workflow whose schema fails to validate (e.g. invalid
+// JSON) is recorded in errs rather than aborting the whole batch; results only contains entries
+// for workflows that were validated, and may still carry validation issues for them.
+func (w *ApplicationService) BatchValidateTree(ctx context.Context, entries []BatchValidateTreeEntry) (
+	results map[int64][]*workflow.ValidateTreeInfo, errs map[int64]error,
+) {
+	results = make(map[int64][]*workflow.ValidateTreeInfo, len(entries))
+	errs = make(map[int64]error)
+	var mu sync.Mutex
+
+	eg, gCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(batchValidateTreeConcurrency)
+	for _, entry := range entries {
+		entry := entry
+		eg.Go(func() error {
+			infos, err := GetWorkflowDomainSVC().ValidateTree(gCtx, entry.WorkflowID, vo.ValidateTreeConfig{
+				CanvasSchema: entry.Schema,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				logs.CtxWarnf(ctx, "[BatchValidateTree] failed to validate workflowID=%d, err=%v", entry.WorkflowID, err)
+				errs[entry.WorkflowID] = err
+				return nil
+			}
+			results[entry.WorkflowID] = infos
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	return results, errs
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-studio/backend/application/base/ctxutil"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+)
+
+// DeprecateWorkflowVersionRequest carries the inputs needed to flag a published workflow version
+// as deprecated.
+type DeprecateWorkflowVersionRequest struct {
+	WorkflowID int64
+	Version    string
+	Message    string
+}
+
+// DeprecateWorkflowVersion flags WorkflowID's Version as deprecated, with an optional message
+// that OpenAPIRun/OpenAPIStreamRun callers still invoking it will see as a warning.
+func (w *ApplicationService) DeprecateWorkflowVersion(ctx context.Context, req *DeprecateWorkflowVersionRequest) error {
+	meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{ID: req.WorkflowID, MetaOnly: true})
+	if err != nil {
+		return err
+	}
+
+	if err = checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), meta.SpaceID); err != nil {
+		return err
+	}
+
+	return GetWorkflowDomainSVC().DeprecateWorkflowVersion(ctx, req.WorkflowID, req.Version, req.Message)
+}
+
+// ListWorkflowVersions returns the published version history of workflowID, newest first,
+// including each version's deprecation status.
+func (w *ApplicationService) ListWorkflowVersions(ctx context.Context, workflowID int64) ([]*vo.VersionMeta, error) {
+	meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{ID: workflowID, MetaOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), meta.SpaceID); err != nil {
+		return nil, err
+	}
+
+	return GetWorkflowDomainSVC().ListWorkflowVersions(ctx, workflowID)
+}
+
+// GetWorkflowChangelog returns workflowID's published version history in chronological order,
+// each entry carrying its description, creator and timestamp plus an auto-generated summary of
+// the nodes added and removed since the previous version.
+func (w *ApplicationService) GetWorkflowChangelog(ctx context.Context, workflowID int64) ([]*vo.ChangelogEntry, error) {
+	meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{ID: workflowID, MetaOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), meta.SpaceID); err != nil {
+		return nil, err
+	}
+
+	return GetWorkflowDomainSVC().GetWorkflowChangelog(ctx, workflowID)
+}
@@ -17,21 +17,31 @@
 package workflow
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/cloudwego/eino/schema"
+	"github.com/getkin/kin-openapi/openapi3"
 	xmaps "golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/coze-dev/coze-studio/backend/api/model/app/bot_common"
 	"github.com/coze-dev/coze-studio/backend/api/model/data/database/table"
+	"github.com/coze-dev/coze-studio/backend/api/model/data/variable/project_memory"
 	"github.com/coze-dev/coze-studio/backend/api/model/playground"
 	pluginAPI "github.com/coze-dev/coze-studio/backend/api/model/plugin_develop"
 	common "github.com/coze-dev/coze-studio/backend/api/model/plugin_develop/common"
@@ -48,17 +58,23 @@ import (
 	crosspermission "github.com/coze-dev/coze-studio/backend/crossdomain/permission"
 	pluginConsts "github.com/coze-dev/coze-studio/backend/crossdomain/plugin/consts"
 	crossuser "github.com/coze-dev/coze-studio/backend/crossdomain/user"
+	crossvariables "github.com/coze-dev/coze-studio/backend/crossdomain/variables"
+	variablesModel "github.com/coze-dev/coze-studio/backend/crossdomain/variables/model"
 	workflowModel "github.com/coze-dev/coze-studio/backend/crossdomain/workflow/model"
 	"github.com/coze-dev/coze-studio/backend/domain/permission"
+	pluginConf "github.com/coze-dev/coze-studio/backend/domain/plugin/conf"
 	"github.com/coze-dev/coze-studio/backend/domain/plugin/dto"
 	search "github.com/coze-dev/coze-studio/backend/domain/search/entity"
 	domainWorkflow "github.com/coze-dev/coze-studio/backend/domain/workflow"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/plugin"
+	"github.com/coze-dev/coze-studio/backend/infra/audit"
 	"github.com/coze-dev/coze-studio/backend/infra/idgen"
 	"github.com/coze-dev/coze-studio/backend/infra/imagex"
+	"github.com/coze-dev/coze-studio/backend/infra/metrics"
 	"github.com/coze-dev/coze-studio/backend/infra/storage"
+	"github.com/coze-dev/coze-studio/backend/pkg/ctxcache"
 	"github.com/coze-dev/coze-studio/backend/pkg/errorx"
 	"github.com/coze-dev/coze-studio/backend/pkg/i18n"
 	"github.com/coze-dev/coze-studio/backend/pkg/lang/conv"
@@ -84,8 +100,153 @@ var (
 	SVC                = &ApplicationService{}
 	nodeIconURLCache   = make(map[string]string)
 	nodeIconURLCacheMu sync.Mutex
+
+	copyTaskProgress   = make(map[int64]*CopyTaskProgress)
+	copyTaskProgressMu sync.Mutex
+
+	// enableConcurrentTemplateCopy toggles the concurrent path in CopyWkTemplateApi.
+	// Flip to false to fall back to sequential copying when debugging batch issues.
+	enableConcurrentTemplateCopy = true
+	copyWkTemplateConcurrency    = 5
+
+	// workflowDetailInfoConcurrency bounds the per-workflow canvas parsing fan-out in
+	// GetWorkflowDetailInfo.
+	workflowDetailInfoConcurrency = 10
+)
+
+// CopyTaskStatus is the lifecycle state of an async copy/duplicate task started via
+// CopyWorkflowFromAppToLibraryAsync.
+type CopyTaskStatus int
+
+const (
+	CopyTaskStatusRunning CopyTaskStatus = iota + 1
+	CopyTaskStatusSuccess
+	CopyTaskStatusFailed
 )
 
+// CopyTaskResourceProgress reports how many of a given resource type have been copied so far.
+type CopyTaskResourceProgress struct {
+	Total int
+	Done  int
+}
+
+// CopyTaskProgress tracks the progress of a single CopyWorkflowFromAppToLibraryAsync task.
+// It is safe for concurrent use: the background goroutine driving the copy updates it while
+// GetCopyTaskStatus callers read a snapshot.
+type CopyTaskProgress struct {
+	mu sync.Mutex
+
+	Status CopyTaskStatus
+	Plugins,
+	Knowledge,
+	Databases,
+	Workflows CopyTaskResourceProgress
+
+	WorkflowID int64
+	ErrMsg     string
+}
+
+func (p *CopyTaskProgress) setTotals(plugins, knowledge, databases int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.Plugins.Total = plugins
+	p.Knowledge.Total = knowledge
+	p.Databases.Total = databases
+}
+
+func (p *CopyTaskProgress) incPlugins() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.Plugins.Done++
+	p.mu.Unlock()
+}
+
+func (p *CopyTaskProgress) incKnowledge() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.Knowledge.Done++
+	p.mu.Unlock()
+}
+
+func (p *CopyTaskProgress) addDatabasesDone(n int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.Databases.Done += n
+	p.Databases.Total = p.Databases.Done
+	p.mu.Unlock()
+}
+
+func (p *CopyTaskProgress) setWorkflowsDone(n int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.Workflows.Done = n
+	p.Workflows.Total = n
+	p.mu.Unlock()
+}
+
+func (p *CopyTaskProgress) finish(workflowID int64, vIssues []*vo.ValidateIssue, err error) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err != nil {
+		p.Status = CopyTaskStatusFailed
+		p.ErrMsg = err.Error()
+		return
+	}
+	if len(vIssues) > 0 {
+		p.Status = CopyTaskStatusFailed
+		p.ErrMsg = "validation failed"
+		return
+	}
+	p.Status = CopyTaskStatusSuccess
+	p.WorkflowID = workflowID
+}
+
+func (p *CopyTaskProgress) snapshot() *CopyTaskProgress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return &CopyTaskProgress{
+		Status:     p.Status,
+		Plugins:    p.Plugins,
+		Knowledge:  p.Knowledge,
+		Databases:  p.Databases,
+		Workflows:  p.Workflows,
+		WorkflowID: p.WorkflowID,
+		ErrMsg:     p.ErrMsg,
+	}
+}
+
+func putCopyTaskProgress(taskID int64, progress *CopyTaskProgress) {
+	copyTaskProgressMu.Lock()
+	copyTaskProgress[taskID] = progress
+	copyTaskProgressMu.Unlock()
+}
+
+// GetCopyTaskStatus returns the current progress of a task started via
+// CopyWorkflowFromAppToLibraryAsync, or false if taskID is unknown.
+func GetCopyTaskStatus(taskID int64) (*CopyTaskProgress, bool) {
+	copyTaskProgressMu.Lock()
+	progress, ok := copyTaskProgress[taskID]
+	copyTaskProgressMu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	return progress.snapshot(), true
+}
+
 func GetWorkflowDomainSVC() domainWorkflow.Service {
 	return SVC.DomainSVC
 }
@@ -201,6 +362,10 @@ func (w *ApplicationService) GetNodeTemplateList(ctx context.Context, req *workf
 				SupportBatch: ternary.IFElse(nodeMeta.SupportBatch, workflow.SupportBatch_SUPPORT, workflow.SupportBatch_NOT_SUPPORT),
 				NodeType:     nodeID,
 				Color:        nodeMeta.Color,
+				Deprecated:   nodeMeta.Deprecated,
+			}
+			if nodeMeta.Deprecated && len(nodeMeta.ReplacedBy) > 0 {
+				tpl.ReplacedBy = ptr.Of(string(nodeMeta.ReplacedBy))
 			}
 
 			resp.Data.TemplateList = append(resp.Data.TemplateList, tpl)
@@ -226,6 +391,12 @@ func (w *ApplicationService) GetNodeTemplateList(ctx context.Context, req *workf
 func (w *ApplicationService) CreateWorkflow(ctx context.Context, req *workflow.CreateWorkflowRequest) (
 	_ *workflow.CreateWorkflowResponse, err error,
 ) {
+	var (
+		uID        int64
+		spaceID    int64
+		workflowID int64
+	)
+
 	defer func() {
 		if panicErr := recover(); panicErr != nil {
 			err = safego.NewPanicErr(panicErr, debug.Stack())
@@ -234,10 +405,12 @@ func (w *ApplicationService) CreateWorkflow(ctx context.Context, req *workflow.C
 		if err != nil {
 			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
 		}
+
+		audit.Record(ctx, uID, "create_workflow", workflowID, spaceID, err)
 	}()
 
-	uID := ctxutil.MustGetUIDFromCtx(ctx)
-	spaceID := mustParseInt64(req.GetSpaceID())
+	uID = ctxutil.MustGetUIDFromCtx(ctx)
+	spaceID = mustParseInt64(req.GetSpaceID())
 	if err := checkUserSpace(ctx, uID, spaceID); err != nil {
 		return nil, err
 	}
@@ -256,6 +429,47 @@ func (w *ApplicationService) CreateWorkflow(ctx context.Context, req *workflow.C
 		}
 	}
 
+	if req.IsSetFromTemplateID() {
+		templateID := mustParseInt64(req.GetFromTemplateID())
+		template, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+			ID:       templateID,
+			QType:    workflowModel.FromDraft,
+			MetaOnly: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if template.SpaceID != consts.TemplateSpaceID {
+			return nil, vo.WrapError(errno.ErrWorkflowNotFound,
+				fmt.Errorf("workflow %d is not a template", templateID), errorx.KV("id", req.GetFromTemplateID()))
+		}
+
+		wf, err := w.copyWorkflow(ctx, templateID, vo.CopyWorkflowPolicy{
+			TargetSpaceID:            ptr.Of(spaceID),
+			TargetAppID:              parseInt64(req.ProjectID),
+			ShouldModifyWorkflowName: false,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err = GetWorkflowDomainSVC().UpdateMeta(ctx, wf.ID, &vo.MetaUpdate{
+			Name:    &req.Name,
+			Desc:    &req.Desc,
+			IconURI: &req.IconURI,
+		}); err != nil {
+			return nil, err
+		}
+
+		workflowID = wf.ID
+
+		return &workflow.CreateWorkflowResponse{
+			Data: &workflow.CreateWorkflowData{
+				WorkflowID: strconv.FormatInt(wf.ID, 10),
+			},
+		}, nil
+	}
+
 	wf := &vo.MetaCreate{
 		CreatorID:        uID,
 		SpaceID:          spaceID,
@@ -270,7 +484,7 @@ func (w *ApplicationService) CreateWorkflow(ctx context.Context, req *workflow.C
 	if req.IsSetFlowMode() && req.GetFlowMode() == workflow.WorkflowMode_ChatFlow {
 		conversationName := req.Name
 		if !req.IsSetProjectID() || mustParseInt64(req.GetProjectID()) == 0 || !createConversation {
-			conversationName = "Default"
+			conversationName = vo.GetDefaultConversationName(i18n.GetLocale(ctx))
 		}
 
 		wf.InitCanvasSchema = vo.GetDefaultInitCanvasJsonSchemaChat(i18n.GetLocale(ctx), conversationName)
@@ -281,6 +495,8 @@ func (w *ApplicationService) CreateWorkflow(ctx context.Context, req *workflow.C
 		return nil, err
 	}
 
+	workflowID = id
+
 	err = PublishWorkflowResource(ctx, id, ptr.Of(int32(wf.Mode)), search.Created, &search.ResourceDocument{
 		Name:          &wf.Name,
 		APPID:         wf.AppID,
@@ -311,13 +527,39 @@ func (w *ApplicationService) SaveWorkflow(ctx context.Context, req *workflow.Sav
 		if err != nil {
 			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
 		}
+
+		audit.Record(ctx, ptr.FromOrDefault(ctxutil.GetUIDFromCtx(ctx), 0), "save_workflow",
+			mustParseInt64(req.WorkflowID), mustParseInt64(req.GetSpaceID()), err)
 	}()
 
 	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), mustParseInt64(req.GetSpaceID())); err != nil {
 		return nil, err
 	}
 
-	if err := GetWorkflowDomainSVC().Save(ctx, mustParseInt64(req.WorkflowID), req.GetSchema()); err != nil {
+	workflowID := mustParseInt64(req.WorkflowID)
+
+	if err := checkEditLock(ctx, workflowID, ctxutil.MustGetUIDFromCtx(ctx)); err != nil {
+		return nil, err
+	}
+
+	if req.GetSubmitCommitID() != "" {
+		draft, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+			ID:       workflowID,
+			QType:    workflowModel.FromDraft,
+			MetaOnly: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if draft.CommitID != req.GetSubmitCommitID() {
+			return nil, vo.WrapError(errno.ErrWorkflowDraftCommitConflict,
+				fmt.Errorf("workflow %d draft commit %s does not match expected commit %s", workflowID, draft.CommitID, req.GetSubmitCommitID()),
+				errorx.KV("id", req.GetWorkflowID()), errorx.KV("expected_commit_id", req.GetSubmitCommitID()), errorx.KV("current_commit_id", draft.CommitID))
+		}
+	}
+
+	if err := GetWorkflowDomainSVC().Save(ctx, workflowID, req.GetSchema()); err != nil {
 		return nil, err
 	}
 
@@ -326,6 +568,68 @@ func (w *ApplicationService) SaveWorkflow(ctx context.Context, req *workflow.Sav
 	}, nil
 }
 
+// SaveWorkflowDraftSnapshot autosaves the current draft as a snapshot, keeping a capped ring
+// of recent snapshots so users can recover intermediate work without bloating the main
+// version history. Space access is checked the same way as SaveWorkflow.
+func (w *ApplicationService) SaveWorkflowDraftSnapshot(ctx context.Context, workflowID, spaceID int64) (_ *vo.DraftSnapshotMeta, err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), spaceID); err != nil {
+		return nil, err
+	}
+
+	return GetWorkflowDomainSVC().SaveDraftSnapshot(ctx, workflowID)
+}
+
+// ListDraftSnapshots lists the autosave draft snapshots retained for a workflow, most recent
+// first. Space access is checked the same way as SaveWorkflow.
+func (w *ApplicationService) ListDraftSnapshots(ctx context.Context, workflowID, spaceID int64) (_ []*vo.DraftSnapshotMeta, err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), spaceID); err != nil {
+		return nil, err
+	}
+
+	return GetWorkflowDomainSVC().ListDraftSnapshots(ctx, workflowID)
+}
+
+// RestoreDraftSnapshot overwrites the current draft with the content of a previously saved
+// autosave snapshot, creating a new draft commit. Space access is checked the same way as
+// SaveWorkflow.
+func (w *ApplicationService) RestoreDraftSnapshot(ctx context.Context, workflowID, spaceID int64, commitID string) (err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), spaceID); err != nil {
+		return err
+	}
+
+	return GetWorkflowDomainSVC().RestoreDraftSnapshot(ctx, workflowID, commitID)
+}
+
 func (w *ApplicationService) UpdateWorkflowMeta(ctx context.Context, req *workflow.UpdateWorkflowMetaRequest) (
 	_ *workflow.UpdateWorkflowMetaResponse, err error,
 ) {
@@ -337,6 +641,9 @@ func (w *ApplicationService) UpdateWorkflowMeta(ctx context.Context, req *workfl
 		if err != nil {
 			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
 		}
+
+		audit.Record(ctx, ptr.FromOrDefault(ctxutil.GetUIDFromCtx(ctx), 0), "update_workflow_meta",
+			mustParseInt64(req.GetWorkflowID()), mustParseInt64(req.GetSpaceID()), err)
 	}()
 
 	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), mustParseInt64(req.GetSpaceID())); err != nil {
@@ -345,6 +652,10 @@ func (w *ApplicationService) UpdateWorkflowMeta(ctx context.Context, req *workfl
 
 	workflowID := mustParseInt64(req.GetWorkflowID())
 
+	if err := checkEditLock(ctx, workflowID, ctxutil.MustGetUIDFromCtx(ctx)); err != nil {
+		return nil, err
+	}
+
 	err = GetWorkflowDomainSVC().UpdateMeta(ctx, mustParseInt64(req.GetWorkflowID()), &vo.MetaUpdate{
 		Name:         req.Name,
 		Desc:         req.Desc,
@@ -407,11 +718,21 @@ func (w *ApplicationService) deleteWorkflowResource(ctx context.Context, policy
 
 func (w *ApplicationService) BatchDeleteWorkflow(ctx context.Context, req *workflow.BatchDeleteWorkflowRequest) (
 	_ *workflow.BatchDeleteWorkflowResponse, err error) {
-	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), mustParseInt64(req.GetSpaceID())); err != nil {
+	actorID := ptr.FromOrDefault(ctxutil.GetUIDFromCtx(ctx), 0)
+	spaceID := mustParseInt64(req.GetSpaceID())
+	var ids []int64
+
+	defer func() {
+		for _, id := range ids {
+			audit.Record(ctx, actorID, "delete_workflow", id, spaceID, err)
+		}
+	}()
+
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), spaceID); err != nil {
 		return nil, err
 	}
 
-	ids, err := slices.TransformWithErrorCheck(req.GetWorkflowIDList(), func(a string) (int64, error) {
+	ids, err = slices.TransformWithErrorCheck(req.GetWorkflowIDList(), func(a string) (int64, error) {
 		return strconv.ParseInt(a, 10, 64)
 	})
 	if err != nil {
@@ -451,10 +772,16 @@ func (w *ApplicationService) GetCanvasInfo(ctx context.Context, req *workflow.Ge
 		}
 	}
 
-	wf, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+	getPolicy := &vo.GetPolicy{
 		ID:    mustParseInt64(req.GetWorkflowID()),
 		QType: workflowModel.FromDraft,
-	})
+	}
+	if req.IsSetVersion() {
+		getPolicy.QType = workflowModel.FromSpecificVersion
+		getPolicy.Version = req.GetVersion()
+	}
+
+	wf, err := GetWorkflowDomainSVC().Get(ctx, getPolicy)
 	if err != nil {
 		return nil, err
 	}
@@ -466,7 +793,7 @@ func (w *ApplicationService) GetCanvasInfo(ctx context.Context, req *workflow.Ge
 
 	vcsType := workflow.VCSCanvasType_Draft
 
-	if !wf.Modified {
+	if req.IsSetVersion() || !wf.Modified {
 		vcsType = workflow.VCSCanvasType_Publish
 		devStatus = workflow.WorkFlowDevStatus_HadSubmit
 	}
@@ -519,11 +846,124 @@ func (w *ApplicationService) GetCanvasInfo(ctx context.Context, req *workflow.Ge
 		WorkflowVersion: wf.LatestPublishedVersion,
 	}
 
+	if lock, held, lErr := GetWorkflowDomainSVC().GetEditLock(ctx, wf.ID); lErr != nil {
+		logs.CtxWarnf(ctx, "[GetCanvasInfo] failed to get edit lock, workflowID=%d, err=%v", wf.ID, lErr)
+	} else if held {
+		canvasData.EditLockHolderID = ptr.Of(strconv.FormatInt(lock.HolderID, 10))
+		canvasData.EditLockExpireTime = ptr.Of(lock.ExpiresAt.Unix())
+	}
+
+	if devStatus == workflow.WorkFlowDevStatus_CanNotSubmit {
+		var blockers []string
+		if !wf.TestRunSuccess {
+			blockers = append(blockers, "no successful test run")
+		}
+		if wf.Modified {
+			blockers = append(blockers, "unsaved changes since the last published version")
+		}
+		if issues, vErr := GetWorkflowDomainSVC().ValidateTree(ctx, wf.ID, vo.ValidateTreeConfig{
+			CanvasSchema: wf.Canvas,
+		}); vErr != nil {
+			logs.CtxWarnf(ctx, "[GetCanvasInfo] failed to validate tree, workflowID=%d, err=%v", wf.ID, vErr)
+		} else {
+			for _, info := range issues {
+				for _, e := range info.Errors {
+					if !e.IsWarning {
+						blockers = append(blockers, e.Message)
+					}
+				}
+			}
+		}
+		canvasData.SubmitBlockers = blockers
+	}
+
+	if vcsType == workflow.VCSCanvasType_Draft && wf.HasPublished {
+		publishedWf, pErr := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+			ID:    wf.ID,
+			QType: workflowModel.FromLatestVersion,
+		})
+		if pErr != nil {
+			logs.CtxWarnf(ctx, "[GetCanvasInfo] failed to get latest published version for diff, workflowID=%d, err=%v", wf.ID, pErr)
+		} else {
+			modifiedNodeIDs, dErr := diffModifiedNodeIDs(wf.Canvas, publishedWf.Canvas)
+			if dErr != nil {
+				logs.CtxWarnf(ctx, "[GetCanvasInfo] failed to diff draft and published canvas, workflowID=%d, err=%v", wf.ID, dErr)
+			} else {
+				canvasData.VcsData.ModifiedNodeIDs = modifiedNodeIDs
+			}
+		}
+	}
+
+	if req.GetAutoMigrate() {
+		migrated, changed, mErr := GetWorkflowDomainSVC().MigrateCanvasSchema(ctx, wf.Canvas)
+		if mErr != nil {
+			logs.CtxWarnf(ctx, "[GetCanvasInfo] failed to migrate canvas schema, workflowID=%d, err=%v", wf.ID, mErr)
+		} else if changed {
+			canvasData.Workflow.SchemaJSON = ptr.Of(migrated)
+		}
+	}
+
 	return &workflow.GetCanvasInfoResponse{
 		Data: canvasData,
 	}, nil
 }
 
+// diffModifiedNodeIDs compares the draft and latest published canvas schemas and returns the
+// IDs of nodes whose configuration differs between the two, recursing into composite nodes'
+// Blocks. Nodes only present in the draft (newly added) are reported as modified too; nodes
+// only present in the published canvas (since removed) are not, as they no longer exist to
+// highlight in the editor.
+func diffModifiedNodeIDs(draftCanvas, publishedCanvas string) ([]string, error) {
+	draft := &vo.Canvas{}
+	if err := sonic.UnmarshalString(draftCanvas, draft); err != nil {
+		return nil, err
+	}
+	published := &vo.Canvas{}
+	if err := sonic.UnmarshalString(publishedCanvas, published); err != nil {
+		return nil, err
+	}
+
+	publishedNodes := make(map[string]*vo.Node)
+	flattenCanvasNodes(published.Nodes, publishedNodes)
+
+	draftNodes := make(map[string]*vo.Node)
+	flattenCanvasNodes(draft.Nodes, draftNodes)
+
+	modifiedNodeIDs := make([]string, 0, len(draftNodes))
+	for id, draftNode := range draftNodes {
+		publishedNode, ok := publishedNodes[id]
+		if !ok {
+			modifiedNodeIDs = append(modifiedNodeIDs, id)
+			continue
+		}
+
+		draftData, err := sonic.MarshalString(draftNode.Data)
+		if err != nil {
+			return nil, err
+		}
+		publishedData, err := sonic.MarshalString(publishedNode.Data)
+		if err != nil {
+			return nil, err
+		}
+		if draftData != publishedData {
+			modifiedNodeIDs = append(modifiedNodeIDs, id)
+		}
+	}
+
+	sort.Strings(modifiedNodeIDs)
+
+	return modifiedNodeIDs, nil
+}
+
+func flattenCanvasNodes(nodes []*vo.Node, out map[string]*vo.Node) {
+	for _, n := range nodes {
+		out[n.ID] = n
+		if len(n.Blocks) > 0 {
+			flattenCanvasNodes(n.Blocks, out)
+		}
+	}
+}
+
 func (w *ApplicationService) TestRun(ctx context.Context, req *workflow.WorkFlowTestRunRequest) (_ *workflow.WorkFlowTestRunResponse, err error) {
 	defer func() {
 		if panicErr := recover(); panicErr != nil {
@@ -541,6 +981,10 @@ func (w *ApplicationService) TestRun(ctx context.Context, req *workflow.WorkFlow
 		return nil, err
 	}
 
+	if err := checkOpenAPIRunParameterLimits(req.Input); err != nil {
+		return nil, err
+	}
+
 	var appID, agentID *int64
 	if req.IsSetProjectID() {
 		appID = ptr.Of(mustParseInt64(req.GetProjectID()))
@@ -563,12 +1007,17 @@ func (w *ApplicationService) TestRun(ctx context.Context, req *workflow.WorkFlow
 		SyncPattern:  workflowModel.SyncPatternAsync,
 		BizType:      workflowModel.BizTypeWorkflow,
 		Cancellable:  true,
+		Breakpoints:  req.GetBreakpoints(),
 	}
 
 	if exeCfg.AppID != nil && exeCfg.AgentID != nil {
 		return nil, errors.New("project_id and bot_id cannot be set at the same time")
 	}
 
+	if err := checkAndIncrementExecutionQuota(mustParseInt64(req.GetSpaceID())); err != nil {
+		return nil, err
+	}
+
 	exeID, err := GetWorkflowDomainSVC().AsyncExecute(ctx, exeCfg, maps.ToAnyValue(req.Input))
 	if err != nil {
 		return nil, err
@@ -613,6 +1062,18 @@ func (w *ApplicationService) NodeDebug(ctx context.Context, req *workflow.Workfl
 		mergedInput[k] = v
 	}
 
+	if req.IsSetSourceExecuteID() && req.IsSetSourceNodeID() {
+		sourced, sErr := sourceNodeDebugInputs(ctx, mustParseInt64(req.GetSourceExecuteID()), req.GetSourceNodeID())
+		if sErr != nil {
+			logs.CtxWarnf(ctx, "[NodeDebug] failed to source inputs from executeID=%s, nodeID=%s, err=%v",
+				req.GetSourceExecuteID(), req.GetSourceNodeID(), sErr)
+		} else {
+			for k, v := range sourced {
+				mergedInput[k] = v
+			}
+		}
+	}
+
 	var appID, agentID *int64
 	if req.IsSetProjectID() {
 		appID = ptr.Of(mustParseInt64(req.GetProjectID()))
@@ -654,6 +1115,32 @@ func (w *ApplicationService) NodeDebug(ctx context.Context, req *workflow.Workfl
 	}, nil
 }
 
+// sourceNodeDebugInputs looks up nodeID's output within sourceExeID and returns it as a
+// string-keyed map, so NodeDebug can feed a node real output from a prior run instead of
+// requiring the user to retype it.
+func sourceNodeDebugInputs(ctx context.Context, sourceExeID int64, nodeID string) (map[string]string, error) {
+	nodeExe, _, err := GetWorkflowDomainSVC().GetNodeExecution(ctx, sourceExeID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if nodeExe.Output == nil || len(*nodeExe.Output) == 0 {
+		return nil, nil
+	}
+
+	var out map[string]any
+	if err := sonic.UnmarshalString(*nodeExe.Output, &out); err != nil {
+		return nil, err
+	}
+
+	sourced := make(map[string]string, len(out))
+	for k, v := range out {
+		sourced[k] = stringifyCSVCell(v)
+	}
+
+	return sourced, nil
+}
+
 func (w *ApplicationService) GetProcess(ctx context.Context, req *workflow.GetWorkflowProcessRequest) (
 	_ *workflow.GetWorkflowProcessResponse, err error,
 ) {
@@ -667,21 +1154,33 @@ func (w *ApplicationService) GetProcess(ctx context.Context, req *workflow.GetWo
 		}
 	}()
 
+	workflowID := mustParseInt64(req.GetWorkflowID())
+	executeID := mustParseInt64(req.GetExecuteID())
+
 	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), mustParseInt64(req.GetSpaceID())); err != nil {
-		return nil, err
+		if !req.IsSetRunShareToken() {
+			return nil, err
+		}
+		// A share link only waives the space-membership check, and only for the single execution
+		// it was minted for; everything else about this request (auth, workflow/execute ID scoping)
+		// still applies as normal.
+		claims, tokenErr := GetWorkflowDomainSVC().ParseRunShareToken(ctx, req.GetRunShareToken())
+		if tokenErr != nil || claims.WorkflowID != workflowID || claims.ExecuteID != executeID {
+			return nil, err
+		}
 	}
 
 	var wfExeEntity *entity.WorkflowExecution
 	if req.SubExecuteID == nil {
 		wfExeEntity = &entity.WorkflowExecution{
-			ID:         mustParseInt64(req.GetExecuteID()),
-			WorkflowID: mustParseInt64(req.GetWorkflowID()),
+			ID:         executeID,
+			WorkflowID: workflowID,
 		}
 	} else {
 		wfExeEntity = &entity.WorkflowExecution{
 			ID:              mustParseInt64(req.GetSubExecuteID()),
-			WorkflowID:      mustParseInt64(req.GetWorkflowID()),
-			RootExecutionID: mustParseInt64(req.GetExecuteID()),
+			WorkflowID:      workflowID,
+			RootExecutionID: executeID,
 		}
 	}
 
@@ -695,6 +1194,13 @@ func (w *ApplicationService) GetProcess(ctx context.Context, req *workflow.GetWo
 		status = entity.WorkflowRunning
 	}
 
+	localizedReason := wfExeEntity.FailReason
+	if localizedReason != nil && wfExeEntity.ErrorCode != nil {
+		if code, cErr := strconv.Atoi(*wfExeEntity.ErrorCode); cErr == nil {
+			localizedReason = ptr.Of(vo.LocalizedErrorMsg(ctx, int32(code), *localizedReason))
+		}
+	}
+
 	resp := &workflow.GetWorkflowProcessResponse{
 		Data: &workflow.GetWorkFlowProcessData{
 			WorkFlowId:       fmt.Sprintf("%d", wfExeEntity.WorkflowID),
@@ -702,9 +1208,10 @@ func (w *ApplicationService) GetProcess(ctx context.Context, req *workflow.GetWo
 			ExecuteStatus:    workflow.WorkflowExeStatus(status),
 			ExeHistoryStatus: workflow.WorkflowExeHistoryStatus_HasHistory,
 			WorkflowExeCost:  fmt.Sprintf("%.3fs", wfExeEntity.Duration.Seconds()),
-			Reason:           wfExeEntity.FailReason,
+			Reason:           localizedReason,
 			LogID:            wfExeEntity.LogID,
 			NodeEvents:       make([]*workflow.NodeEvent, 0),
+			InterruptCount:   ptr.Of(wfExeEntity.InterruptCount),
 		},
 	}
 
@@ -774,7 +1281,7 @@ func (w *ApplicationService) GetProcess(ctx context.Context, req *workflow.GetWo
 	if workflowFail && !hasNodeErr {
 		var failReason string
 		if wfExeEntity.FailReason != nil {
-			failReason = *wfExeEntity.FailReason
+			failReason = *localizedReason
 			if endNodeExe != nil {
 				endNodeExe.ErrorInfo = failReason
 				endNodeExe.ErrorLevel = string(vo.LevelError)
@@ -820,6 +1327,7 @@ func (w *ApplicationService) GetProcess(ctx context.Context, req *workflow.GetWo
 				NodeIcon:      ie.ToolInterruptEvent.NodeIcon,
 				EventType:     ie.ToolInterruptEvent.EventType,
 				InterruptData: ie.ToolInterruptEvent.InterruptData,
+				InputSchema:   ie.ToolInterruptEvent.InputSchema,
 			}
 		}
 
@@ -833,28 +1341,120 @@ func (w *ApplicationService) GetProcess(ctx context.Context, req *workflow.GetWo
 			Data:         ie.InterruptData,
 			Type:         ie.EventType,
 			SchemaNodeID: string(ie.NodeKey),
+			InputSchema:  optionalStr(ie.InputSchema),
 		})
 	}
 
+	recordExecutionMetrics(wfExeEntity)
+
 	return resp, nil
 }
 
-func (w *ApplicationService) GetNodeExecuteHistory(ctx context.Context, req *workflow.GetNodeExecuteHistoryRequest) (
-	_ *workflow.GetNodeExecuteHistoryResponse, err error,
+// defaultRunShareLinkTTL and maxRunShareLinkTTL bound the validity window callers can request for
+// a run-share link: unset requests get the default, and requests exceeding the max are capped to
+// it rather than rejected.
+const (
+	defaultRunShareLinkTTL = 24 * time.Hour
+	maxRunShareLinkTTL     = 7 * 24 * time.Hour
+)
+
+// CreateRunShareLink mints a scoped, expiring token granting read-only access to executeID's
+// process, for sharing a run with someone outside the workflow's space. GetProcess accepts the
+// token in place of the normal space-membership check, limited to that single execution; there is
+// no separate trace/log export path in this codebase for the token to cover.
+func (w *ApplicationService) CreateRunShareLink(ctx context.Context, req *workflow.CreateRunShareLinkRequest) (
+	_ *workflow.CreateRunShareLinkResponse, err error,
 ) {
 	defer func() {
 		if panicErr := recover(); panicErr != nil {
 			err = safego.NewPanicErr(panicErr, debug.Stack())
 		}
-
 		if err != nil {
 			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
 		}
 	}()
 
-	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), mustParseInt64(req.GetSpaceID())); err != nil {
-		return nil, err
-	}
+	spaceID := mustParseInt64(req.GetSpaceID())
+	workflowID := mustParseInt64(req.GetWorkflowID())
+	executeID := mustParseInt64(req.GetExecuteID())
+
+	ttl := defaultRunShareLinkTTL
+	if req.IsSetTTLSeconds() {
+		ttl = time.Duration(req.GetTTLSeconds()) * time.Second
+	}
+	if ttl > maxRunShareLinkTTL {
+		ttl = maxRunShareLinkTTL
+	}
+
+	exe, err := GetWorkflowDomainSVC().GetExecution(ctx, &entity.WorkflowExecution{ID: executeID}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// workflowID/spaceID are caller-supplied, so the execution they actually resolve to -
+	// rather than the caller's say-so - is what checkUserSpace and the minted token are scoped to.
+	if exe.WorkflowID != workflowID || exe.SpaceID != spaceID {
+		return nil, vo.WrapError(errno.ErrWorkflowPermissionDenied,
+			fmt.Errorf("execution %d does not belong to workflow %d in space %d", executeID, workflowID, spaceID))
+	}
+
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), exe.SpaceID); err != nil {
+		return nil, err
+	}
+
+	token, err := GetWorkflowDomainSVC().CreateRunShareToken(ctx, exe.WorkflowID, exe.ID, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflow.CreateRunShareLinkResponse{Token: token}, nil
+}
+
+// recordExecutionMetrics emits workflow-level metrics once an execution has reached a
+// terminal status. It's called from the execution read paths (GetProcess/GetExecution)
+// rather than SyncExecute/AsyncExecute, since that's where node-level durations, token
+// usage, and interrupt events are already assembled on the entity.
+func recordExecutionMetrics(wfExeEntity *entity.WorkflowExecution) {
+	switch wfExeEntity.Status {
+	case entity.WorkflowSuccess, entity.WorkflowFailed, entity.WorkflowCancel:
+	default:
+		return
+	}
+
+	mode := string(wfExeEntity.Mode)
+	metrics.WorkflowExecutionsTotal.WithLabelValues(mode, strconv.Itoa(int(wfExeEntity.Status))).Inc()
+
+	if wfExeEntity.TokenInfo != nil {
+		metrics.TokenUsageTotal.WithLabelValues("input").Add(float64(wfExeEntity.TokenInfo.InputTokens))
+		metrics.TokenUsageTotal.WithLabelValues("output").Add(float64(wfExeEntity.TokenInfo.OutputTokens))
+	}
+
+	for _, nodeExe := range wfExeEntity.NodeExecutions {
+		metrics.NodeExecutionDurationSeconds.WithLabelValues(string(nodeExe.NodeType), strconv.Itoa(int(nodeExe.Status))).
+			Observe(nodeExe.Duration.Seconds())
+	}
+
+	if len(wfExeEntity.InterruptEvents) > 0 {
+		metrics.InterruptsTotal.WithLabelValues(mode).Add(float64(len(wfExeEntity.InterruptEvents)))
+	}
+}
+
+func (w *ApplicationService) GetNodeExecuteHistory(ctx context.Context, req *workflow.GetNodeExecuteHistoryRequest) (
+	_ *workflow.GetNodeExecuteHistoryResponse, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), mustParseInt64(req.GetSpaceID())); err != nil {
+		return nil, err
+	}
 
 	executeID := req.GetExecuteID()
 	scene := req.GetNodeHistoryScene()
@@ -957,6 +1557,161 @@ func (w *ApplicationService) DeleteWorkflowsByAppID(ctx context.Context, appID i
 	})
 }
 
+func (w *ApplicationService) GenerateInputExample(ctx context.Context, workflowID int64) (_ string, err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	return GetWorkflowDomainSVC().GenerateInputExample(ctx, workflowID)
+}
+
+func (w *ApplicationService) GetWorkflowOutputSchema(ctx context.Context, workflowID int64) (_ string, err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	return GetWorkflowDomainSVC().GetWorkflowOutputSchema(ctx, workflowID)
+}
+
+// GetWorkflowComplexity returns a governance-facing complexity score for workflowID's draft
+// canvas, along with the sub-scores (node count, branching depth, sub-workflow nesting depth,
+// external reference count) that drive it.
+func (w *ApplicationService) GetWorkflowComplexity(ctx context.Context, workflowID int64) (_ *vo.WorkflowComplexity, err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	return GetWorkflowDomainSVC().GetWorkflowComplexity(ctx, workflowID)
+}
+
+// SaveTestRunPreset creates or overwrites, by name, one of the current user's saved test-run
+// input sets for workflowID.
+func (w *ApplicationService) SaveTestRunPreset(ctx context.Context, workflowID int64, name string, input map[string]string) (err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	uID := ctxutil.MustGetUIDFromCtx(ctx)
+	return GetWorkflowDomainSVC().SaveTestRunPreset(ctx, workflowID, uID, name, input)
+}
+
+// ListTestRunPresets returns the current user's saved test-run input sets for workflowID.
+func (w *ApplicationService) ListTestRunPresets(ctx context.Context, workflowID int64) (_ []*entity.TestRunPreset, err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	uID := ctxutil.MustGetUIDFromCtx(ctx)
+	return GetWorkflowDomainSVC().ListTestRunPresets(ctx, workflowID, uID)
+}
+
+// DeleteTestRunPreset removes one of the current user's named test-run input sets for
+// workflowID.
+func (w *ApplicationService) DeleteTestRunPreset(ctx context.Context, workflowID int64, name string) (err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	uID := ctxutil.MustGetUIDFromCtx(ctx)
+	return GetWorkflowDomainSVC().DeleteTestRunPreset(ctx, workflowID, uID, name)
+}
+
+// GetLatestSuccessfulTestRun returns the current user's most recent successful test run of
+// workflowID, so the debug UI can offer "re-run last good inputs".
+func (w *ApplicationService) GetLatestSuccessfulTestRun(ctx context.Context, workflowID, spaceID int64) (
+	_ *entity.WorkflowExecution, found bool, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	uID := ctxutil.MustGetUIDFromCtx(ctx)
+	if err := checkUserSpace(ctx, uID, spaceID); err != nil {
+		return nil, false, err
+	}
+
+	return GetWorkflowDomainSVC().GetLatestSuccessfulExecution(ctx, workflowID, uID)
+}
+
+// GetLatestFailedTestRun returns the current user's most recent failed test run of workflowID,
+// so the debug UI can offer "inspect last failure".
+func (w *ApplicationService) GetLatestFailedTestRun(ctx context.Context, workflowID, spaceID int64) (
+	_ *entity.WorkflowExecution, found bool, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	uID := ctxutil.MustGetUIDFromCtx(ctx)
+	if err := checkUserSpace(ctx, uID, spaceID); err != nil {
+		return nil, false, err
+	}
+
+	return GetWorkflowDomainSVC().GetLatestFailedExecution(ctx, workflowID, uID)
+}
+
+func (w *ApplicationService) InferLatestTestRunOutputSchema(ctx context.Context, workflowID int64) (_ []*vo.Variable, err error) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	return GetWorkflowDomainSVC().InferLatestTestRunOutputSchema(ctx, workflowID, ctxutil.MustGetUIDFromCtx(ctx))
+}
+
 func (w *ApplicationService) CheckWorkflowsExistByAppID(ctx context.Context, appID int64) (_ bool, err error) {
 	defer func() {
 		if panicErr := recover(); panicErr != nil {
@@ -985,6 +1740,51 @@ func (w *ApplicationService) CheckWorkflowsExistByAppID(ctx context.Context, app
 
 func (w *ApplicationService) CopyWorkflowFromAppToLibrary(ctx context.Context, workflowID int64, spaceID, appID int64) (
 	_ int64, _ []*vo.ValidateIssue, err error) {
+	newID, vIssues, _, err := w.copyWorkflowFromAppToLibraryWithProgress(ctx, workflowID, spaceID, appID, nil)
+	return newID, vIssues, err
+}
+
+// CopyResourceIDMapping reports, for every resource copied as part of a
+// CopyWorkflowFromAppToLibrary operation, the mapping from the original resource ID in the
+// source app to its newly created counterpart in the library. Downstream systems that kept
+// references to the originals can use it to update those references after the copy.
+type CopyResourceIDMapping struct {
+	PluginMap     map[int64]int64
+	PluginToolMap map[int64]int64
+	KnowledgeMap  map[int64]int64
+	DatabaseMap   map[int64]int64
+	WorkflowMap   map[int64]int64
+}
+
+// CopyWorkflowFromAppToLibraryDetailed behaves like CopyWorkflowFromAppToLibrary but also
+// returns the full old->new ID mapping for every resource type touched by the copy.
+func (w *ApplicationService) CopyWorkflowFromAppToLibraryDetailed(ctx context.Context, workflowID int64, spaceID, appID int64) (
+	int64, []*vo.ValidateIssue, *CopyResourceIDMapping, error) {
+	return w.copyWorkflowFromAppToLibraryWithProgress(ctx, workflowID, spaceID, appID, nil)
+}
+
+// CopyWorkflowFromAppToLibraryAsync starts a CopyWorkflowFromAppToLibrary operation in the
+// background and returns a task ID immediately. Callers poll GetCopyTaskStatus with the
+// returned ID to learn when the copy finishes and how far it has gotten in the meantime.
+func (w *ApplicationService) CopyWorkflowFromAppToLibraryAsync(ctx context.Context, workflowID int64, spaceID, appID int64) (int64, error) {
+	taskID, err := w.IDGenerator.GenID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	progress := &CopyTaskProgress{Status: CopyTaskStatusRunning}
+	putCopyTaskProgress(taskID, progress)
+
+	safego.Go(ctx, func() {
+		resultID, vIssues, _, err := w.copyWorkflowFromAppToLibraryWithProgress(ctx, workflowID, spaceID, appID, progress)
+		progress.finish(resultID, vIssues, err)
+	})
+
+	return taskID, nil
+}
+
+func (w *ApplicationService) copyWorkflowFromAppToLibraryWithProgress(ctx context.Context, workflowID int64, spaceID, appID int64, progress *CopyTaskProgress) (
+	_ int64, _ []*vo.ValidateIssue, _ *CopyResourceIDMapping, err error) {
 	defer func() {
 		if panicErr := recover(); panicErr != nil {
 			err = safego.NewPanicErr(panicErr, debug.Stack())
@@ -997,9 +1797,11 @@ func (w *ApplicationService) CopyWorkflowFromAppToLibrary(ctx context.Context, w
 
 	ds, err := GetWorkflowDomainSVC().GetWorkflowDependenceResource(ctx, workflowID)
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 
+	progress.setTotals(len(ds.PluginIDs), len(ds.KnowledgeIDs), len(ds.DatabaseIDs))
+
 	pluginMap := make(map[int64]*vo.PluginEntity)
 	pluginToolMap := make(map[int64]int64)
 
@@ -1012,7 +1814,7 @@ func (w *ApplicationService) CopyWorkflowFromAppToLibrary(ctx context.Context, w
 				CopyScene: pluginConsts.CopySceneOfToLibrary,
 			})
 			if err != nil {
-				return 0, nil, err
+				return 0, nil, nil, err
 			}
 			pInfo := response.Plugin
 			pluginMap[id] = &vo.PluginEntity{
@@ -1023,6 +1825,7 @@ func (w *ApplicationService) CopyWorkflowFromAppToLibrary(ctx context.Context, w
 				pluginToolMap[o] = n.ID
 			}
 
+			progress.incPlugins()
 		}
 	}
 
@@ -1030,7 +1833,7 @@ func (w *ApplicationService) CopyWorkflowFromAppToLibrary(ctx context.Context, w
 	if len(ds.KnowledgeIDs) > 0 {
 		taskUniqIDs, err := w.IDGenerator.GenMultiIDs(ctx, len(ds.KnowledgeIDs))
 		if err != nil {
-			return 0, nil, err
+			return 0, nil, nil, err
 		}
 
 		for idx := range ds.KnowledgeIDs {
@@ -1042,12 +1845,14 @@ func (w *ApplicationService) CopyWorkflowFromAppToLibrary(ctx context.Context, w
 				TaskUniqKey:   strconv.FormatInt(taskUniqIDs[idx], 10),
 			})
 			if err != nil {
-				return 0, nil, err
+				return 0, nil, nil, err
 			}
 			if response.CopyStatus == model.CopyStatus_Failed {
-				return 0, nil, fmt.Errorf("failed to copy knowledge, knowledge id=%d", id)
+				return 0, nil, nil, fmt.Errorf("failed to copy knowledge, knowledge id=%d", id)
 			}
 			relatedKnowledgeMap[id] = response.TargetKnowledgeID
+
+			progress.incKnowledge()
 		}
 	}
 
@@ -1059,12 +1864,13 @@ func (w *ApplicationService) CopyWorkflowFromAppToLibrary(ctx context.Context, w
 			CreatorID:   ctxutil.MustGetUIDFromCtx(ctx),
 		})
 		if err != nil {
-			return 0, nil, err
+			return 0, nil, nil, err
 		}
 		for oid, e := range response.Databases {
 			relatedDatabaseMap[oid] = e.ID
 		}
 
+		progress.addDatabasesDone(len(response.Databases))
 	}
 
 	relatedWorkflows, vIssues, err := w.copyWorkflowFromAppToLibrary(ctx, workflowID, appID, vo.ExternalResourceRelated{
@@ -1074,19 +1880,37 @@ func (w *ApplicationService) CopyWorkflowFromAppToLibrary(ctx context.Context, w
 		DatabaseMap:   relatedDatabaseMap,
 	})
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 
+	progress.setWorkflowsDone(len(relatedWorkflows))
+
 	if len(vIssues) > 0 {
-		return 0, vIssues, nil
+		return 0, vIssues, nil, nil
 	}
 
 	copiedWf, ok := relatedWorkflows[workflowID]
 	if !ok {
-		return 0, nil, fmt.Errorf("failed to get copy workflow id, workflow id=%d", workflowID)
+		return 0, nil, nil, fmt.Errorf("failed to get copy workflow id, workflow id=%d", workflowID)
 	}
 
-	return copiedWf.ID, vIssues, nil
+	pluginIDMap := make(map[int64]int64, len(pluginMap))
+	for oid, p := range pluginMap {
+		pluginIDMap[oid] = p.PluginID
+	}
+	workflowMap := make(map[int64]int64, len(relatedWorkflows))
+	for oid, p := range relatedWorkflows {
+		workflowMap[oid] = p.ID
+	}
+	mapping := &CopyResourceIDMapping{
+		PluginMap:     pluginIDMap,
+		PluginToolMap: pluginToolMap,
+		KnowledgeMap:  relatedKnowledgeMap,
+		DatabaseMap:   relatedDatabaseMap,
+		WorkflowMap:   workflowMap,
+	}
+
+	return copiedWf.ID, vIssues, mapping, nil
 }
 
 func (w *ApplicationService) copyWorkflowFromAppToLibrary(ctx context.Context, workflowID int64, appID int64, related vo.ExternalResourceRelated) (map[int64]entity.IDVersionPair, []*vo.ValidateIssue, error) {
@@ -1226,6 +2050,8 @@ func (w *ApplicationService) MoveWorkflowFromAppToLibrary(ctx context.Context, w
 		if err != nil {
 			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
 		}
+
+		audit.Record(ctx, ptr.FromOrDefault(ctxutil.GetUIDFromCtx(ctx), 0), "move_workflow", workflowID, spaceID, err)
 	}()
 
 	ds, err := GetWorkflowDomainSVC().GetWorkflowDependenceResource(ctx, workflowID)
@@ -1331,12 +2157,19 @@ func convertNodeExecution(nodeExe *entity.NodeExecution) (*workflow.NodeResult,
 
 	if len(nodeExe.IndexedExecutions) > 0 {
 		nr.IsBatch = ptr.Of(true)
+		var successCount, failCount int32
 		subResults := make([]*workflow.NodeResult, 0, len(nodeExe.IndexedExecutions))
 		for _, subNodeExe := range nodeExe.IndexedExecutions {
 			if subNodeExe == nil {
 				subResults = append(subResults, nil)
 				continue
 			}
+			switch subNodeExe.Status {
+			case entity.NodeSuccess:
+				successCount++
+			case entity.NodeFailed:
+				failCount++
+			}
 			subResult, err := convertNodeExecution(subNodeExe)
 			if err != nil {
 				return nil, err
@@ -1348,6 +2181,9 @@ func convertNodeExecution(nodeExe *entity.NodeExecution) (*workflow.NodeResult,
 			return nil, err
 		}
 		nr.Batch = ptr.Of(m)
+		nr.BatchSuccessCount = ptr.Of(successCount)
+		nr.BatchFailCount = ptr.Of(failCount)
+		nr.BatchTotal = ptr.Of(int32(len(nodeExe.IndexedExecutions)))
 	}
 
 	if nodeExe.SubWorkflowExecution != nil {
@@ -1372,28 +2208,71 @@ func convertNodeExecution(nodeExe *entity.NodeExecution) (*workflow.NodeResult,
 
 func mergeBatchModeNodes(parent, inner *workflow.NodeResult) *workflow.NodeResult {
 	merged := &workflow.NodeResult{
-		NodeId:       parent.NodeId,
-		NodeType:     inner.NodeType,
-		NodeName:     parent.NodeName,
-		NodeStatus:   parent.NodeStatus,
-		ErrorInfo:    parent.ErrorInfo,
-		Input:        parent.Input,
-		Output:       parent.Output,
-		NodeExeCost:  parent.NodeExeCost,
-		TokenAndCost: parent.TokenAndCost,
-		RawOutput:    parent.RawOutput,
-		ErrorLevel:   parent.ErrorLevel,
-		Batch:        inner.Batch,
-		IsBatch:      inner.IsBatch,
-		Extra:        inner.Extra,
-		ExecuteId:    parent.ExecuteId,
-		SubExecuteId: parent.SubExecuteId,
-		NeedAsync:    parent.NeedAsync,
+		NodeId:            parent.NodeId,
+		NodeType:          inner.NodeType,
+		NodeName:          parent.NodeName,
+		NodeStatus:        parent.NodeStatus,
+		ErrorInfo:         parent.ErrorInfo,
+		Input:             parent.Input,
+		Output:            parent.Output,
+		NodeExeCost:       parent.NodeExeCost,
+		TokenAndCost:      parent.TokenAndCost,
+		RawOutput:         parent.RawOutput,
+		ErrorLevel:        parent.ErrorLevel,
+		Batch:             inner.Batch,
+		IsBatch:           inner.IsBatch,
+		Extra:             inner.Extra,
+		ExecuteId:         parent.ExecuteId,
+		SubExecuteId:      parent.SubExecuteId,
+		NeedAsync:         parent.NeedAsync,
+		BatchSuccessCount: inner.BatchSuccessCount,
+		BatchFailCount:    inner.BatchFailCount,
+		BatchTotal:        inner.BatchTotal,
 	}
 
 	return merged
 }
 
+// validateResumeData checks resumeData against the expected input schema of the interrupt event
+// identified by (executeID, eventID), when that event is the one currently awaiting resume and
+// declared a schema (see vo.ValidateResumeData). If the event can't be found - e.g. it was
+// already resumed, or never derived a schema - validation is skipped and AsyncResume/StreamResume
+// are left to report their own errors about the eventID itself.
+func validateResumeData(ctx context.Context, executeID, eventID int64, resumeData string) error {
+	wfExe, err := GetWorkflowDomainSVC().GetExecution(ctx, &entity.WorkflowExecution{ID: executeID}, false)
+	if err != nil {
+		return err
+	}
+
+	for _, ie := range wfExe.InterruptEvents {
+		if ie.ID != eventID {
+			continue
+		}
+
+		inputSchema := ie.InputSchema
+		if ie.ToolInterruptEvent != nil {
+			inputSchema = ie.ToolInterruptEvent.InputSchema
+		}
+
+		if vErr := vo.ValidateResumeData(inputSchema, resumeData); vErr != nil {
+			return vo.WrapError(errno.ErrResumeDataInvalid, vErr, errorx.KV("cause", vErr.Error()))
+		}
+
+		break
+	}
+
+	return nil
+}
+
+// optionalStr returns nil for an empty string, and a pointer to s otherwise, for thrift fields
+// that are optional and should be omitted rather than sent as an empty string.
+func optionalStr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 type StreamRunEventType string
 
 const (
@@ -1403,7 +2282,7 @@ const (
 	InterruptEvent StreamRunEventType = "Interrupt"
 )
 
-func convertStreamRunEvent(workflowID int64) func(msg *entity.Message) (res *workflow.OpenAPIStreamRunFlowResponse, err error) {
+func convertStreamRunEvent(workflowID int64, deprecationWarning *string) func(msg *entity.Message) (res *workflow.OpenAPIStreamRunFlowResponse, err error) {
 	var (
 		messageID  int
 		executeID  int64
@@ -1427,11 +2306,15 @@ func convertStreamRunEvent(workflowID int64) func(msg *entity.Message) (res *wor
 
 			switch msg.StateMessage.Status {
 			case entity.WorkflowSuccess:
-				return &workflow.OpenAPIStreamRunFlowResponse{
+				doneResp := &workflow.OpenAPIStreamRunFlowResponse{
 					ID:       strconv.Itoa(messageID),
 					Event:    string(DoneEvent),
 					DebugUrl: ptr.Of(debugutil.GetWorkflowDebugURL(ctx, workflowID, spaceID, executeID)),
-				}, nil
+				}
+				if deprecationWarning != nil {
+					doneResp.Ext = map[string]string{"deprecation_warning": *deprecationWarning}
+				}
+				return doneResp, nil
 			case entity.WorkflowFailed, entity.WorkflowCancel:
 				var wfe vo.WorkflowError
 				if !errors.As(msg.StateMessage.LastError, &wfe) {
@@ -1442,7 +2325,7 @@ func convertStreamRunEvent(workflowID int64) func(msg *entity.Message) (res *wor
 					Event:        string(ErrEvent),
 					DebugUrl:     ptr.Of(debugutil.GetWorkflowDebugURL(ctx, workflowID, spaceID, executeID)),
 					ErrorCode:    ptr.Of(int64(wfe.Code())),
-					ErrorMessage: ptr.Of(wfe.Msg()),
+					ErrorMessage: ptr.Of(wfe.LocalizedMsg(ctx)),
 				}, nil
 			case entity.WorkflowInterrupted:
 				if msg.InterruptEvent.ToolInterruptEvent == nil {
@@ -1451,9 +2334,10 @@ func convertStreamRunEvent(workflowID int64) func(msg *entity.Message) (res *wor
 						Event:    string(InterruptEvent),
 						DebugUrl: ptr.Of(debugutil.GetWorkflowDebugURL(ctx, workflowID, spaceID, executeID)),
 						InterruptData: &workflow.Interrupt{
-							EventID: fmt.Sprintf("%d/%d", executeID, msg.InterruptEvent.ID),
-							Type:    workflow.InterruptType(msg.InterruptEvent.EventType),
-							InData:  msg.InterruptEvent.InterruptData,
+							EventID:     fmt.Sprintf("%d/%d", executeID, msg.InterruptEvent.ID),
+							Type:        workflow.InterruptType(msg.InterruptEvent.EventType),
+							InData:      msg.InterruptEvent.InterruptData,
+							InputSchema: optionalStr(msg.InterruptEvent.InputSchema),
 						},
 					}, nil
 				}
@@ -1463,9 +2347,10 @@ func convertStreamRunEvent(workflowID int64) func(msg *entity.Message) (res *wor
 					Event:    string(InterruptEvent),
 					DebugUrl: ptr.Of(debugutil.GetWorkflowDebugURL(ctx, workflowID, spaceID, executeID)),
 					InterruptData: &workflow.Interrupt{
-						EventID: fmt.Sprintf("%d/%d", executeID, msg.InterruptEvent.ID),
-						Type:    workflow.InterruptType(msg.InterruptEvent.ToolInterruptEvent.EventType),
-						InData:  msg.InterruptEvent.ToolInterruptEvent.InterruptData,
+						EventID:     fmt.Sprintf("%d/%d", executeID, msg.InterruptEvent.ID),
+						Type:        workflow.InterruptType(msg.InterruptEvent.ToolInterruptEvent.EventType),
+						InData:      msg.InterruptEvent.ToolInterruptEvent.InterruptData,
+						InputSchema: optionalStr(msg.InterruptEvent.ToolInterruptEvent.InputSchema),
 					},
 				}, nil
 			case entity.WorkflowRunning:
@@ -1512,6 +2397,24 @@ func convertStreamRunEvent(workflowID int64) func(msg *entity.Message) (res *wor
 
 			res.NodeSeqID = ptr.Of(strconv.Itoa(seq))
 			nodeID2Seq[msg.NodeID]++
+
+			if res.Content != nil {
+				moderated, mErr := moderateText(ctx, *res.Content)
+				if mErr != nil {
+					var wfe vo.WorkflowError
+					if !errors.As(mErr, &wfe) {
+						return nil, mErr
+					}
+					return &workflow.OpenAPIStreamRunFlowResponse{
+						ID:           strconv.Itoa(messageID),
+						Event:        string(ErrEvent),
+						DebugUrl:     ptr.Of(debugutil.GetWorkflowDebugURL(ctx, workflowID, spaceID, executeID)),
+						ErrorCode:    ptr.Of(int64(wfe.Code())),
+						ErrorMessage: ptr.Of(wfe.LocalizedMsg(ctx)),
+					}, nil
+				}
+				res.Content = ptr.Of(moderated)
+			}
 		}
 
 		return res, nil
@@ -1540,12 +2443,9 @@ func (w *ApplicationService) OpenAPIStreamRun(ctx context.Context, req *workflow
 		}
 		return nil
 	}()
-	parameters := make(map[string]any)
-	if req.Parameters != nil {
-		err := sonic.UnmarshalString(*req.Parameters, &parameters)
-		if err != nil {
-			return nil, vo.WrapError(errno.ErrInvalidParameter, err)
-		}
+	parameters, err := unmarshalOpenAPIRunParameters(req.Parameters)
+	if err != nil {
+		return nil, err
 	}
 
 	meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
@@ -1564,6 +2464,15 @@ func (w *ApplicationService) OpenAPIStreamRun(ctx context.Context, req *workflow
 		return nil, err
 	}
 
+	publishedWf, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+		ID:      meta.ID,
+		QType:   workflowModel.FromSpecificVersion,
+		Version: *meta.LatestPublishedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var appID, agentID *int64
 	if req.IsSetAppID() {
 		appID = ptr.Of(mustParseInt64(req.GetAppID()))
@@ -1579,8 +2488,17 @@ func (w *ApplicationService) OpenAPIStreamRun(ctx context.Context, req *workflow
 		connectorID = mustParseInt64(req.GetConnectorID())
 	}
 
-	if connectorID != consts.WebSDKConnectorID {
+	connectorBehavior := getConnectorBehavior(connectorID)
+	if !connectorBehavior.HonorConnectorID {
 		connectorID = apiKeyInfo.ConnectorID
+		connectorBehavior = getConnectorBehavior(connectorID)
+	}
+
+	if err = checkConnectorAllowsWorkflow(connectorID, connectorBehavior, meta.ID); err != nil {
+		return nil, err
+	}
+	if err = checkConnectorRateLimit(connectorID, connectorBehavior); err != nil {
+		return nil, err
 	}
 
 	exeCfg := workflowModel.ExecuteConfig{
@@ -1598,22 +2516,27 @@ func (w *ApplicationService) OpenAPIStreamRun(ctx context.Context, req *workflow
 			}
 			return strconv.FormatInt(userID, 10)
 		}(),
-		TaskType:      workflowModel.TaskTypeForeground,
-		SyncPattern:   workflowModel.SyncPatternStream,
-		InputFailFast: true,
-		BizType:       workflowModel.BizTypeWorkflow,
+		TaskType:          workflowModel.TaskTypeForeground,
+		SyncPattern:       workflowModel.SyncPatternStream,
+		InputFailFast:     true,
+		BizType:           workflowModel.BizTypeWorkflow,
+		ExperimentVariant: req.Ext["experiment_variant"],
 	}
 
 	if exeCfg.AppID != nil && exeCfg.AgentID != nil {
 		return nil, errors.New("project_id and bot_id cannot be set at the same time")
 	}
 
-	sr, err := GetWorkflowDomainSVC().StreamExecute(ctx, exeCfg, parameters)
+	if err = checkAndIncrementExecutionQuota(meta.SpaceID); err != nil {
+		return nil, err
+	}
+
+	sr, err := GetWorkflowDomainSVC().StreamExecute(ctx, exeCfg, parameters)
 	if err != nil {
 		return nil, err
 	}
 
-	convert := convertStreamRunEvent(meta.ID)
+	convert := convertStreamRunEvent(meta.ID, deprecationWarningFor(publishedWf))
 
 	return schema.StreamReaderWithConvert(sr, convert), nil
 }
@@ -1654,6 +2577,10 @@ func (w *ApplicationService) OpenAPIStreamResume(ctx context.Context, req *workf
 		ResumeData: req.ResumeData,
 	}
 
+	if err := validateResumeData(ctx, executeID, eventID, req.ResumeData); err != nil {
+		return nil, err
+	}
+
 	apiKeyInfo := ctxutil.GetApiAuthFromCtx(ctx)
 	userID := apiKeyInfo.UserID
 	runtimeUserID := func() *string {
@@ -1703,11 +2630,183 @@ func (w *ApplicationService) OpenAPIStreamResume(ctx context.Context, req *workf
 		return nil, err
 	}
 
-	convert := convertStreamRunEvent(workflowID)
+	convert := convertStreamRunEvent(workflowID, nil)
 
 	return schema.StreamReaderWithConvert(sr, convert), nil
 }
 
+const (
+	// maxOpenAPIRunParametersBytes caps the serialized size of the Parameters payload
+	// accepted by OpenAPIRun/OpenAPIStreamRun/TestRun, to keep a single oversized request
+	// from exhausting the executing worker's memory.
+	maxOpenAPIRunParametersBytes = 1 << 20 // 1MB
+	// maxOpenAPIRunParameterCount caps the number of top-level parameters in that payload.
+	maxOpenAPIRunParameterCount = 200
+)
+
+// unmarshalOpenAPIRunParameters parses the raw Parameters JSON for OpenAPIRun and
+// OpenAPIStreamRun, rejecting payloads that exceed the size or top-level parameter
+// count limits before they reach the executor.
+func unmarshalOpenAPIRunParameters(raw *string) (map[string]any, error) {
+	parameters := make(map[string]any)
+	if raw == nil {
+		return parameters, nil
+	}
+
+	if len(*raw) > maxOpenAPIRunParametersBytes {
+		return nil, vo.WrapError(errno.ErrInvalidParameter,
+			fmt.Errorf("parameters payload of %d bytes exceeds the %d byte limit", len(*raw), maxOpenAPIRunParametersBytes))
+	}
+
+	if err := sonic.UnmarshalString(*raw, &parameters); err != nil {
+		return nil, vo.WrapError(errno.ErrInvalidParameter, err)
+	}
+
+	if len(parameters) > maxOpenAPIRunParameterCount {
+		return nil, vo.WrapError(errno.ErrInvalidParameter,
+			fmt.Errorf("parameters has %d top-level fields, exceeding the limit of %d", len(parameters), maxOpenAPIRunParameterCount))
+	}
+
+	return parameters, nil
+}
+
+// checkOpenAPIRunParameterLimits applies the same size/count limits as
+// unmarshalOpenAPIRunParameters to an already-decoded parameter map, for callers
+// (such as TestRun) that receive parameters as a map[string]string instead of a
+// raw JSON payload.
+func checkOpenAPIRunParameterLimits(parameters map[string]string) error {
+	if len(parameters) > maxOpenAPIRunParameterCount {
+		return vo.WrapError(errno.ErrInvalidParameter,
+			fmt.Errorf("parameters has %d top-level fields, exceeding the limit of %d", len(parameters), maxOpenAPIRunParameterCount))
+	}
+
+	size := 0
+	for k, v := range parameters {
+		size += len(k) + len(v)
+	}
+	if size > maxOpenAPIRunParametersBytes {
+		return vo.WrapError(errno.ErrInvalidParameter,
+			fmt.Errorf("parameters payload of %d bytes exceeds the %d byte limit", size, maxOpenAPIRunParametersBytes))
+	}
+
+	return nil
+}
+
+// openAPIRunResultCacheKey derives the result-cache key for a synchronous OpenAPIRun call,
+// from the workflow's published version and its input parameters. encoding/json marshals map
+// keys in sorted order at every nesting level, so two calls with the same parameters but a
+// different key ordering hash to the same key.
+func openAPIRunResultCacheKey(workflowID int64, version string, parameters map[string]any) (string, error) {
+	normalized, err := json.Marshal(parameters)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(append([]byte(fmt.Sprintf("%d:%s:", workflowID, version)), normalized...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// cachedOpenAPIRunResult is the JSON payload stored by the result cache for a single
+// synchronous OpenAPIRun call, covering the fields that differ between executions of the
+// same workflow version and input.
+type cachedOpenAPIRunResult struct {
+	Data         *string           `json:"data,omitempty"`
+	Token        *int64            `json:"token,omitempty"`
+	ContentTypes map[string]string `json:"content_types,omitempty"`
+}
+
+// validateParameterConstraints checks that values provided to OpenAPIRun satisfy the
+// enum, range, length, and pattern constraints declared on their corresponding input
+// parameter, recursing into object fields and array-of-object elements. Parameters with
+// no declared constraints, or whose value is absent, are left unchecked.
+func validateParameterConstraints(params []*vo.NamedTypeInfo, values map[string]any) error {
+	for _, p := range params {
+		v, ok := values[p.Name]
+		if !ok || v == nil {
+			continue
+		}
+
+		if len(p.Enum) > 0 {
+			s, ok := v.(string)
+			if !ok || !slices.Contains(p.Enum, s) {
+				return vo.WrapError(errno.ErrInvalidParameter,
+					fmt.Errorf("parameter '%s' must be one of %v, got %v", p.Name, p.Enum, v),
+					errorx.KV("parameter", p.Name), errorx.KV("constraint", "enum"))
+			}
+		}
+
+		if p.Min != nil || p.Max != nil {
+			n, ok := v.(float64)
+			if !ok {
+				return vo.WrapError(errno.ErrInvalidParameter,
+					fmt.Errorf("parameter '%s' must be numeric", p.Name),
+					errorx.KV("parameter", p.Name), errorx.KV("constraint", "type"))
+			}
+			if p.Min != nil && n < *p.Min {
+				return vo.WrapError(errno.ErrInvalidParameter,
+					fmt.Errorf("parameter '%s' must be >= %v, got %v", p.Name, *p.Min, n),
+					errorx.KV("parameter", p.Name), errorx.KV("constraint", "min"))
+			}
+			if p.Max != nil && n > *p.Max {
+				return vo.WrapError(errno.ErrInvalidParameter,
+					fmt.Errorf("parameter '%s' must be <= %v, got %v", p.Name, *p.Max, n),
+					errorx.KV("parameter", p.Name), errorx.KV("constraint", "max"))
+			}
+		}
+
+		if p.MinLength != nil || p.MaxLength != nil || p.Pattern != nil {
+			if s, ok := v.(string); ok {
+				if p.MinLength != nil && len(s) < *p.MinLength {
+					return vo.WrapError(errno.ErrInvalidParameter,
+						fmt.Errorf("parameter '%s' must have length >= %d, got %d", p.Name, *p.MinLength, len(s)),
+						errorx.KV("parameter", p.Name), errorx.KV("constraint", "min_length"))
+				}
+				if p.MaxLength != nil && len(s) > *p.MaxLength {
+					return vo.WrapError(errno.ErrInvalidParameter,
+						fmt.Errorf("parameter '%s' must have length <= %d, got %d", p.Name, *p.MaxLength, len(s)),
+						errorx.KV("parameter", p.Name), errorx.KV("constraint", "max_length"))
+				}
+				if p.Pattern != nil {
+					matched, err := regexp.MatchString(*p.Pattern, s)
+					if err != nil || !matched {
+						return vo.WrapError(errno.ErrInvalidParameter,
+							fmt.Errorf("parameter '%s' must match pattern %q, got %q", p.Name, *p.Pattern, s),
+							errorx.KV("parameter", p.Name), errorx.KV("constraint", "pattern"))
+					}
+				}
+			}
+		}
+
+		switch p.Type {
+		case vo.DataTypeObject:
+			if sub, ok := v.(map[string]any); ok {
+				if err := validateParameterConstraints(p.Properties, sub); err != nil {
+					return err
+				}
+			}
+		case vo.DataTypeArray:
+			if p.ElemTypeInfo == nil || p.ElemTypeInfo.Type != vo.DataTypeObject {
+				continue
+			}
+			arr, ok := v.([]any)
+			if !ok {
+				continue
+			}
+			for _, item := range arr {
+				sub, ok := item.(map[string]any)
+				if !ok {
+					continue
+				}
+				if err := validateParameterConstraints(p.ElemTypeInfo.Properties, sub); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func (w *ApplicationService) OpenAPIRun(ctx context.Context, req *workflow.OpenAPIRunFlowRequest) (
 	_ *workflow.OpenAPIRunFlowResponse, err error,
 ) {
@@ -1730,12 +2829,9 @@ func (w *ApplicationService) OpenAPIRun(ctx context.Context, req *workflow.OpenA
 		return nil
 	}()
 
-	parameters := make(map[string]any)
-	if req.Parameters != nil {
-		err := sonic.UnmarshalString(*req.Parameters, &parameters)
-		if err != nil {
-			return nil, vo.WrapError(errno.ErrInvalidParameter, err)
-		}
+	parameters, err := unmarshalOpenAPIRunParameters(req.Parameters)
+	if err != nil {
+		return nil, err
 	}
 
 	meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
@@ -1754,6 +2850,18 @@ func (w *ApplicationService) OpenAPIRun(ctx context.Context, req *workflow.OpenA
 		return nil, err
 	}
 
+	publishedWf, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+		ID:      meta.ID,
+		QType:   workflowModel.FromSpecificVersion,
+		Version: *meta.LatestPublishedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = validateParameterConstraints(publishedWf.InputParams, parameters); err != nil {
+		return nil, err
+	}
+
 	var appID, agentID *int64
 	if req.IsSetAppID() {
 		appID = ptr.Of(mustParseInt64(req.GetAppID()))
@@ -1769,8 +2877,17 @@ func (w *ApplicationService) OpenAPIRun(ctx context.Context, req *workflow.OpenA
 		connectorID = mustParseInt64(req.GetConnectorID())
 	}
 
-	if connectorID != consts.WebSDKConnectorID {
+	connectorBehavior := getConnectorBehavior(connectorID)
+	if !connectorBehavior.HonorConnectorID {
 		connectorID = apiKeyInfo.ConnectorID
+		connectorBehavior = getConnectorBehavior(connectorID)
+	}
+
+	if err = checkConnectorAllowsWorkflow(connectorID, connectorBehavior, meta.ID); err != nil {
+		return nil, err
+	}
+	if err = checkConnectorRateLimit(connectorID, connectorBehavior); err != nil {
+		return nil, err
 	}
 
 	exeCfg := workflowModel.ExecuteConfig{
@@ -1788,14 +2905,21 @@ func (w *ApplicationService) OpenAPIRun(ctx context.Context, req *workflow.OpenA
 			}
 			return strconv.FormatInt(userID, 10)
 		}(),
-		InputFailFast: true,
-		BizType:       workflowModel.BizTypeWorkflow,
+		InputFailFast:     true,
+		BizType:           workflowModel.BizTypeWorkflow,
+		ExperimentVariant: req.Ext["experiment_variant"],
 	}
 
 	if exeCfg.AppID != nil && exeCfg.AgentID != nil {
 		return nil, errors.New("project_id and bot_id cannot be set at the same time")
 	}
 
+	if err = checkAndIncrementExecutionQuota(meta.SpaceID); err != nil {
+		return nil, err
+	}
+
+	deprecationWarning := deprecationWarningFor(publishedWf)
+
 	if req.GetIsAsync() {
 		exeCfg.SyncPattern = workflowModel.SyncPatternAsync
 		exeCfg.TaskType = workflowModel.TaskTypeBackground
@@ -1805,11 +2929,37 @@ func (w *ApplicationService) OpenAPIRun(ctx context.Context, req *workflow.OpenA
 		}
 
 		return &workflow.OpenAPIRunFlowResponse{
-			ExecuteID: ptr.Of(strconv.FormatInt(exeID, 10)),
-			DebugUrl:  ptr.Of(debugutil.GetWorkflowDebugURL(ctx, meta.ID, meta.SpaceID, exeID)),
+			ExecuteID:          ptr.Of(strconv.FormatInt(exeID, 10)),
+			DebugUrl:           ptr.Of(debugutil.GetWorkflowDebugURL(ctx, meta.ID, meta.SpaceID, exeID)),
+			DeprecationWarning: deprecationWarning,
 		}, nil
 	}
 
+	var cacheKey string
+	if meta.CacheEnabled && meta.CacheTTLSeconds > 0 {
+		cacheKey, err = openAPIRunResultCacheKey(meta.ID, *meta.LatestPublishedVersion, parameters)
+		if err != nil {
+			return nil, err
+		}
+
+		if cached, ok, cErr := GetWorkflowDomainSVC().GetCachedOpenAPIResult(ctx, cacheKey); cErr != nil {
+			return nil, cErr
+		} else if ok {
+			var result cachedOpenAPIRunResult
+			if err = sonic.UnmarshalString(cached, &result); err != nil {
+				return nil, err
+			}
+
+			return &workflow.OpenAPIRunFlowResponse{
+				Data:               result.Data,
+				Token:              result.Token,
+				Cost:               ptr.Of("0.00000"),
+				ContentTypes:       result.ContentTypes,
+				DeprecationWarning: deprecationWarning,
+			}, nil
+		}
+	}
+
 	exeCfg.SyncPattern = workflowModel.SyncPatternSync
 	exeCfg.TaskType = workflowModel.TaskTypeForeground
 	wfExe, tPlan, err := GetWorkflowDomainSVC().SyncExecute(ctx, exeCfg, parameters)
@@ -1818,12 +2968,40 @@ func (w *ApplicationService) OpenAPIRun(ctx context.Context, req *workflow.OpenA
 	}
 
 	if wfExe.Status == entity.WorkflowInterrupted {
-		return nil, vo.NewError(errno.ErrInterruptNotSupported)
+		if !req.GetAllowPartialOutputOnInterrupt() {
+			return nil, vo.NewError(errno.ErrInterruptNotSupported)
+		}
+
+		partialOutput, pErr := w.collectPartialOutputs(ctx, wfExe)
+		if pErr != nil {
+			return nil, pErr
+		}
+
+		return &workflow.OpenAPIRunFlowResponse{
+			Data:               partialOutput,
+			Interrupted:        ptr.Of(true),
+			ExecuteID:          ptr.Of(strconv.FormatInt(wfExe.ID, 10)),
+			DebugUrl:           ptr.Of(debugutil.GetWorkflowDebugURL(ctx, meta.ID, wfExe.SpaceID, wfExe.ID)),
+			Token:              ptr.Of(wfExe.TokenInfo.InputTokens + wfExe.TokenInfo.OutputTokens),
+			Cost:               ptr.Of("0.00000"),
+			DeprecationWarning: deprecationWarning,
+		}, nil
 	}
 
-	var data *string
+	var (
+		data         *string
+		contentTypes map[string]string
+	)
 	if tPlan == vo.ReturnVariables {
 		data = wfExe.Output
+		if data != nil {
+			resolved, types, rErr := w.resolveFileOutputs(ctx, meta.ID, *meta.LatestPublishedVersion, *data)
+			if rErr != nil {
+				return nil, rErr
+			}
+			data = resolved
+			contentTypes = types
+		}
 	} else {
 		answerOutput := map[string]any{
 			"content_type":   1,
@@ -1839,15 +3017,125 @@ func (w *ApplicationService) OpenAPIRun(ctx context.Context, req *workflow.OpenA
 		data = ptr.Of(answerOutputStr)
 	}
 
+	if data != nil {
+		moderated, mErr := moderateText(ctx, *data)
+		if mErr != nil {
+			return nil, mErr
+		}
+		data = ptr.Of(moderated)
+	}
+
+	token := ptr.Of(wfExe.TokenInfo.InputTokens + wfExe.TokenInfo.OutputTokens)
+
+	if cacheKey != "" {
+		cached, cErr := sonic.MarshalString(cachedOpenAPIRunResult{Data: data, Token: token, ContentTypes: contentTypes})
+		if cErr != nil {
+			return nil, cErr
+		}
+		if cErr = GetWorkflowDomainSVC().CacheOpenAPIResult(ctx, cacheKey, cached, time.Duration(meta.CacheTTLSeconds)*time.Second); cErr != nil {
+			return nil, cErr
+		}
+	}
+
 	return &workflow.OpenAPIRunFlowResponse{
-		Data:      data,
-		ExecuteID: ptr.Of(strconv.FormatInt(wfExe.ID, 10)),
-		DebugUrl:  ptr.Of(debugutil.GetWorkflowDebugURL(ctx, meta.ID, wfExe.SpaceID, wfExe.ID)),
-		Token:     ptr.Of(wfExe.TokenInfo.InputTokens + wfExe.TokenInfo.OutputTokens),
-		Cost:      ptr.Of("0.00000"),
+		Data:               data,
+		ExecuteID:          ptr.Of(strconv.FormatInt(wfExe.ID, 10)),
+		DebugUrl:           ptr.Of(debugutil.GetWorkflowDebugURL(ctx, meta.ID, wfExe.SpaceID, wfExe.ID)),
+		Token:              token,
+		Cost:               ptr.Of("0.00000"),
+		ContentTypes:       contentTypes,
+		DeprecationWarning: deprecationWarning,
 	}, nil
 }
 
+// collectPartialOutputs gathers the outputs of the nodes that had already completed when
+// wfExe interrupted, keyed by node name, so a non-interactive OpenAPI caller that opted into
+// AllowPartialOutputOnInterrupt can capture partial results instead of only getting an error.
+func (w *ApplicationService) collectPartialOutputs(ctx context.Context, wfExe *entity.WorkflowExecution) (*string, error) {
+	full, err := GetWorkflowDomainSVC().GetExecution(ctx, wfExe, true)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make(map[string]string)
+	for _, nodeExe := range full.NodeExecutions {
+		if nodeExe.Status == entity.NodeSuccess && nodeExe.Output != nil {
+			outputs[nodeExe.NodeName] = *nodeExe.Output
+		}
+	}
+
+	data, err := sonic.MarshalString(outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return ptr.Of(data), nil
+}
+
+// resolveFileOutputs rewrites file-type output variables (currently stored as a raw
+// storage URI) into resolved, directly-fetchable URLs, and reports the file sub-type
+// of each rewritten variable so API callers don't have to resolve URIs themselves.
+func (w *ApplicationService) resolveFileOutputs(ctx context.Context, workflowID int64, version string, output string) (*string, map[string]string, error) {
+	wf, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+		ID:      workflowID,
+		QType:   workflowModel.FromSpecificVersion,
+		Version: version,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(wf.OutputParamsStr) == 0 {
+		return &output, nil, nil
+	}
+
+	var outputTypes []*vo.NamedTypeInfo
+	if err := sonic.UnmarshalString(wf.OutputParamsStr, &outputTypes); err != nil {
+		return nil, nil, err
+	}
+
+	outputVars := map[string]any{}
+	if err := sonic.UnmarshalString(output, &outputVars); err != nil {
+		return &output, nil, nil
+	}
+
+	contentTypes := make(map[string]string)
+	for _, t := range outputTypes {
+		if t.Type != vo.DataTypeFile {
+			continue
+		}
+
+		uri, ok := outputVars[t.Name].(string)
+		if !ok || uri == "" {
+			continue
+		}
+
+		resourceURL, err := w.ImageX.GetResourceURL(ctx, uri)
+		if err != nil {
+			logs.CtxWarnf(ctx, "failed to resolve output file url for %s: %v", t.Name, err)
+			continue
+		}
+
+		outputVars[t.Name] = resourceURL.URL
+		if t.FileType != nil {
+			contentTypes[t.Name] = string(*t.FileType)
+		} else {
+			contentTypes[t.Name] = string(vo.FileTypeDefault)
+		}
+	}
+
+	if len(contentTypes) == 0 {
+		return &output, nil, nil
+	}
+
+	resolved, err := sonic.MarshalString(outputVars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &resolved, contentTypes, nil
+}
+
 func (w *ApplicationService) OpenAPIGetWorkflowRunHistory(ctx context.Context, req *workflow.GetWorkflowRunHistoryRequest) (
 	_ *workflow.GetWorkflowRunHistoryResponse, err error,
 ) {
@@ -1909,7 +3197,7 @@ func (w *ApplicationService) OpenAPIGetWorkflowRunHistory(ctx context.Context, r
 				Token:         ptr.Of(exe.TokenInfo.InputTokens + exe.TokenInfo.OutputTokens),
 				Cost:          ptr.Of("0.00000"),
 				ErrorCode:     exe.ErrorCode,
-				ErrorMsg:      exe.FailReason,
+				ErrorMsg:      localizedFailReason(ctx, exe.ErrorCode, exe.FailReason),
 			},
 		},
 	}
@@ -1917,6 +3205,21 @@ func (w *ApplicationService) OpenAPIGetWorkflowRunHistory(ctx context.Context, r
 	return res, nil
 }
 
+// localizedFailReason translates failReason into ctx's locale when errCode identifies a
+// registered, placeholder-free error message, otherwise it returns failReason unchanged.
+func localizedFailReason(ctx context.Context, errCode, failReason *string) *string {
+	if errCode == nil || failReason == nil {
+		return failReason
+	}
+
+	code, err := strconv.Atoi(*errCode)
+	if err != nil {
+		return failReason
+	}
+
+	return ptr.Of(vo.LocalizedErrorMsg(ctx, int32(code), *failReason))
+}
+
 func (w *ApplicationService) ValidateTree(ctx context.Context, req *workflow.ValidateTreeRequest) (
 	_ *workflow.ValidateTreeResponse, err error,
 ) {
@@ -1946,6 +3249,21 @@ func (w *ApplicationService) ValidateTree(ctx context.Context, req *workflow.Val
 		}
 		validateTreeCfg.AppID = ptr.Of(pId)
 	}
+	if req.GetTargetAppID() != "" {
+		targetAppID, err := strconv.ParseInt(req.GetTargetAppID(), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		validateTreeCfg.TargetAppID = ptr.Of(targetAppID)
+	}
+	if req.GetTargetSpaceID() != "" {
+		targetSpaceID, err := strconv.ParseInt(req.GetTargetSpaceID(), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		validateTreeCfg.TargetSpaceID = ptr.Of(targetSpaceID)
+	}
+	validateTreeCfg.AnnotateByElement = req.GetAnnotateByElement()
 
 	wfValidateInfos, err := GetWorkflowDomainSVC().ValidateTree(ctx, mustParseInt64(req.GetWorkflowID()), validateTreeCfg)
 	if err != nil {
@@ -1956,6 +3274,347 @@ func (w *ApplicationService) ValidateTree(ctx context.Context, req *workflow.Val
 	return response, nil
 }
 
+// ValidateNode validates a single node's configuration, identified by its node type and the raw
+// JSON of its canvas "data" field, against that node type's expected schema. It is a cheaper
+// alternative to ValidateTree for live per-field validation while a node is being edited, since it
+// does not require the rest of the canvas.
+func (w *ApplicationService) ValidateNode(ctx context.Context, nodeType string, nodeConfig string) (
+	_ []*workflow.ValidateErrorData, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	if len(nodeConfig) == 0 {
+		return nil, errors.New("validate node config is required")
+	}
+
+	return GetWorkflowDomainSVC().ValidateNode(ctx, entity.NodeType(nodeType), nodeConfig)
+}
+
+// GetWorkflowStartForm returns the input parameter schema of a workflow's start node,
+// so callers can render a form for it without fetching and parsing the full canvas.
+func (w *ApplicationService) GetWorkflowStartForm(ctx context.Context, req *workflow.GetWorkflowStartFormRequest) (
+	_ *workflow.GetWorkflowStartFormResponse, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	wf, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+		ID:       mustParseInt64(req.GetWorkflowID()),
+		QType:    workflowModel.FromDraft,
+		CommitID: req.GetCommitID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &workflow.GetWorkflowStartFormResponse{
+		Data: make([]*workflow.Parameter, 0, len(wf.InputParams)),
+	}
+	for _, in := range wf.InputParams {
+		param, err := toWorkflowParameter(in)
+		if err != nil {
+			return nil, err
+		}
+		response.Data = append(response.Data, param)
+	}
+
+	return response, nil
+}
+
+// GetWorkflowMCPTool returns a published workflow's definition as an MCP (Model Context
+// Protocol) tool, so MCP integrators can expose it without reimplementing the conversion
+// from the workflow's InputParams/OutputParams to JSON Schema.
+func (w *ApplicationService) GetWorkflowMCPTool(ctx context.Context, req *workflow.GetWorkflowMCPToolRequest) (
+	_ *workflow.GetWorkflowMCPToolResponse, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+		ID:       mustParseInt64(req.GetWorkflowID()),
+		MetaOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if meta.LatestPublishedVersion == nil {
+		return nil, vo.NewError(errno.ErrWorkflowNotPublished)
+	}
+
+	publishedWf, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+		ID:      meta.ID,
+		QType:   workflowModel.FromSpecificVersion,
+		Version: *meta.LatestPublishedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inputSchema, err := sonic.MarshalString(namedTypeInfoListToJSONSchema(publishedWf.InputParams))
+	if err != nil {
+		return nil, vo.WrapError(errno.ErrSerializationDeserializationFail, err)
+	}
+
+	outputSchema, err := sonic.MarshalString(namedTypeInfoListToJSONSchema(publishedWf.OutputParams))
+	if err != nil {
+		return nil, vo.WrapError(errno.ErrSerializationDeserializationFail, err)
+	}
+
+	return &workflow.GetWorkflowMCPToolResponse{
+		Data: &workflow.MCPToolDefinition{
+			Name:         publishedWf.Name,
+			Description:  publishedWf.Desc,
+			InputSchema:  inputSchema,
+			OutputSchema: outputSchema,
+		},
+	}, nil
+}
+
+// namedTypeInfoListToJSONSchema converts a flat list of named parameters into a JSON
+// Schema object describing them, e.g. the top-level input or output of a workflow.
+func namedTypeInfoListToJSONSchema(params []*vo.NamedTypeInfo) map[string]any {
+	properties := make(map[string]any, len(params))
+	required := make([]string, 0, len(params))
+	for _, p := range params {
+		properties[p.Name] = namedTypeInfoToJSONSchema(p)
+		if p.Required {
+			required = append(required, p.Name)
+		}
+	}
+
+	s := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+
+	return s
+}
+
+// namedTypeInfoToJSONSchema converts a single parameter into a JSON Schema fragment,
+// recursing into object properties and array elements.
+func namedTypeInfoToJSONSchema(n *vo.NamedTypeInfo) map[string]any {
+	s := map[string]any{}
+	if n.Desc != "" {
+		s["description"] = n.Desc
+	}
+
+	switch n.Type {
+	case vo.DataTypeString, vo.DataTypeTime, vo.DataTypeFile:
+		s["type"] = "string"
+	case vo.DataTypeInteger:
+		s["type"] = "integer"
+	case vo.DataTypeNumber:
+		s["type"] = "number"
+	case vo.DataTypeBoolean:
+		s["type"] = "boolean"
+	case vo.DataTypeObject:
+		sub := namedTypeInfoListToJSONSchema(n.Properties)
+		s["type"] = sub["type"]
+		s["properties"] = sub["properties"]
+		if req, ok := sub["required"]; ok {
+			s["required"] = req
+		}
+	case vo.DataTypeArray:
+		s["type"] = "array"
+		if n.ElemTypeInfo != nil {
+			s["items"] = namedTypeInfoToJSONSchema(n.ElemTypeInfo)
+		}
+	}
+
+	if len(n.Enum) > 0 {
+		s["enum"] = n.Enum
+	}
+	if n.Min != nil {
+		s["minimum"] = *n.Min
+	}
+	if n.Max != nil {
+		s["maximum"] = *n.Max
+	}
+	if n.MinLength != nil {
+		s["minLength"] = *n.MinLength
+	}
+	if n.MaxLength != nil {
+		s["maxLength"] = *n.MaxLength
+	}
+	if n.Pattern != nil {
+		s["pattern"] = *n.Pattern
+	}
+
+	return s
+}
+
+// GetWorkflowOpenAPI3Spec generates an OpenAPI 3 document describing a published workflow's
+// run endpoint, with request/response schemas derived from InputParams/OutputParams, so API
+// consumers can codegen clients for that specific workflow.
+func (w *ApplicationService) GetWorkflowOpenAPI3Spec(ctx context.Context, req *workflow.GetWorkflowOpenAPI3SpecRequest) (
+	_ *workflow.GetWorkflowOpenAPI3SpecResponse, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	meta, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+		ID:       mustParseInt64(req.GetWorkflowID()),
+		MetaOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if meta.LatestPublishedVersion == nil {
+		return nil, vo.NewError(errno.ErrWorkflowNotPublished)
+	}
+
+	publishedWf, err := GetWorkflowDomainSVC().Get(ctx, &vo.GetPolicy{
+		ID:      meta.ID,
+		QType:   workflowModel.FromSpecificVersion,
+		Version: *meta.LatestPublishedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &openapi3.T{
+		OpenAPI: "3.0.1",
+		Info: &openapi3.Info{
+			Title:       publishedWf.Name,
+			Description: publishedWf.Desc,
+			Version:     *meta.LatestPublishedVersion,
+		},
+		Paths: openapi3.Paths{
+			"/v1/workflow/run": &openapi3.PathItem{
+				Post: &openapi3.Operation{
+					OperationID: fmt.Sprintf("run_workflow_%d", meta.ID),
+					Summary:     publishedWf.Name,
+					Description: publishedWf.Desc,
+					RequestBody: &openapi3.RequestBodyRef{
+						Value: &openapi3.RequestBody{
+							Content: openapi3.Content{
+								"application/json": &openapi3.MediaType{
+									Schema: &openapi3.SchemaRef{Value: namedTypeInfoListToOpenAPI3Schema(publishedWf.InputParams)},
+								},
+							},
+						},
+					},
+					Responses: openapi3.Responses{
+						strconv.Itoa(http.StatusOK): &openapi3.ResponseRef{
+							Value: &openapi3.Response{
+								Description: ptr.Of("successful workflow run"),
+								Content: openapi3.Content{
+									"application/json": &openapi3.MediaType{
+										Schema: &openapi3.SchemaRef{Value: namedTypeInfoListToOpenAPI3Schema(publishedWf.OutputParams)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	data, err := sonic.MarshalString(doc)
+	if err != nil {
+		return nil, vo.WrapError(errno.ErrSerializationDeserializationFail, err)
+	}
+
+	return &workflow.GetWorkflowOpenAPI3SpecResponse{Data: data}, nil
+}
+
+// namedTypeInfoListToOpenAPI3Schema converts a flat list of named parameters into an
+// object-typed OpenAPI 3 schema describing them, e.g. the top-level input or output of a
+// workflow.
+func namedTypeInfoListToOpenAPI3Schema(params []*vo.NamedTypeInfo) *openapi3.Schema {
+	s := &openapi3.Schema{
+		Type:       openapi3.TypeObject,
+		Properties: make(openapi3.Schemas, len(params)),
+	}
+	for _, p := range params {
+		s.Properties[p.Name] = &openapi3.SchemaRef{Value: namedTypeInfoToOpenAPI3Schema(p)}
+		if p.Required {
+			s.Required = append(s.Required, p.Name)
+		}
+	}
+
+	return s
+}
+
+// namedTypeInfoToOpenAPI3Schema converts a single parameter into an OpenAPI 3 schema,
+// recursing into object properties and array elements.
+func namedTypeInfoToOpenAPI3Schema(n *vo.NamedTypeInfo) *openapi3.Schema {
+	s := &openapi3.Schema{Description: n.Desc}
+
+	switch n.Type {
+	case vo.DataTypeString, vo.DataTypeTime, vo.DataTypeFile:
+		s.Type = openapi3.TypeString
+	case vo.DataTypeInteger:
+		s.Type = openapi3.TypeInteger
+	case vo.DataTypeNumber:
+		s.Type = openapi3.TypeNumber
+	case vo.DataTypeBoolean:
+		s.Type = openapi3.TypeBoolean
+	case vo.DataTypeObject:
+		sub := namedTypeInfoListToOpenAPI3Schema(n.Properties)
+		s.Type = sub.Type
+		s.Properties = sub.Properties
+		s.Required = sub.Required
+	case vo.DataTypeArray:
+		s.Type = openapi3.TypeArray
+		if n.ElemTypeInfo != nil {
+			s.Items = &openapi3.SchemaRef{Value: namedTypeInfoToOpenAPI3Schema(n.ElemTypeInfo)}
+		}
+	}
+
+	for _, e := range n.Enum {
+		s.Enum = append(s.Enum, e)
+	}
+	s.Min = n.Min
+	s.Max = n.Max
+	if n.MinLength != nil {
+		s.MinLength = uint64(*n.MinLength)
+	}
+	if n.MaxLength != nil {
+		s.MaxLength = ptr.Of(uint64(*n.MaxLength))
+	}
+	if n.Pattern != nil {
+		s.Pattern = *n.Pattern
+	}
+
+	return s
+}
+
 func (w *ApplicationService) GetWorkflowReferences(ctx context.Context, req *workflow.GetWorkflowReferencesRequest) (
 	_ *workflow.GetWorkflowReferencesResponse, err error,
 ) {
@@ -1974,80 +3633,314 @@ func (w *ApplicationService) GetWorkflowReferences(ctx context.Context, req *wor
 			return nil, err
 		}
 	}
-	workflows, err := GetWorkflowDomainSVC().GetWorkflowReference(ctx, mustParseInt64(req.GetWorkflowID()))
+	workflows, err := GetWorkflowDomainSVC().GetWorkflowReference(ctx, mustParseInt64(req.GetWorkflowID()))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &workflow.GetWorkflowReferencesResponse{}
+	response.Data = &workflow.WorkflowReferencesData{
+		WorkflowList: make([]*workflow.Workflow, 0, len(workflows)),
+	}
+	for id, wk := range workflows {
+		wfw := &workflow.Workflow{
+			WorkflowID:       strconv.FormatInt(id, 10),
+			Name:             wk.Name,
+			Desc:             wk.Desc,
+			URL:              wk.IconURL,
+			IconURI:          wk.IconURI,
+			Status:           workflow.WorkFlowDevStatus_HadSubmit,
+			CreateTime:       wk.CreatedAt.Unix(),
+			Tag:              wk.Tag,
+			TemplateAuthorID: ptr.Of(strconv.FormatInt(wk.AuthorID, 10)),
+			SpaceID:          ptr.Of(strconv.FormatInt(wk.SpaceID, 10)),
+			Creator: &workflow.Creator{
+				ID: strconv.FormatInt(wk.CreatorID, 10),
+			},
+			FlowMode: wk.Mode,
+		}
+
+		if wk.UpdatedAt != nil {
+			wfw.UpdateTime = wk.UpdatedAt.Unix()
+		}
+
+		if wk.AppID != nil {
+			wfw.ProjectID = ptr.Of(strconv.FormatInt(ptr.From(wk.AppID), 10))
+		}
+
+		response.Data.WorkflowList = append(response.Data.WorkflowList, wfw)
+	}
+
+	return response, nil
+}
+
+func (w *ApplicationService) TestResume(ctx context.Context, req *workflow.WorkflowTestResumeRequest) (
+	_ *workflow.WorkflowTestResumeResponse, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowExecuteFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), mustParseInt64(req.GetSpaceID())); err != nil {
+		return nil, err
+	}
+
+	resumeReq := &entity.ResumeRequest{
+		ExecuteID:  mustParseInt64(req.GetExecuteID()),
+		EventID:    mustParseInt64(req.GetEventID()),
+		ResumeData: req.GetData(),
+	}
+
+	if err := validateResumeData(ctx, resumeReq.ExecuteID, resumeReq.EventID, resumeReq.ResumeData); err != nil {
+		return nil, err
+	}
+
+	err = GetWorkflowDomainSVC().AsyncResume(ctx, resumeReq, workflowModel.ExecuteConfig{
+		Operator:    ptr.FromOrDefault(ctxutil.GetUIDFromCtx(ctx), 0),
+		Mode:        workflowModel.ExecuteModeDebug, // at this stage it could be debug or node debug, we will decide it within AsyncResume
+		BizType:     workflowModel.BizTypeWorkflow,
+		Cancellable: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflow.WorkflowTestResumeResponse{}, nil
+}
+
+// InspectVariables reports the in-scope variables of a paused debug execution: the outputs of
+// the nodes that have completed so far, and the current values of the global variables
+// referenced anywhere in the workflow. It gives a debugger-style watch window to complement
+// breakpoints (see ExecuteConfig.Breakpoints).
+func (w *ApplicationService) InspectVariables(ctx context.Context, req *workflow.InspectVariablesRequest) (
+	_ *workflow.InspectVariablesResponse, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), mustParseInt64(req.GetSpaceID())); err != nil {
+		return nil, err
+	}
+
+	wfExe, err := GetWorkflowDomainSVC().GetExecution(ctx, &entity.WorkflowExecution{
+		ID:         mustParseInt64(req.GetExecuteID()),
+		WorkflowID: mustParseInt64(req.GetWorkflowID()),
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if wfExe.Status != entity.WorkflowInterrupted {
+		return nil, errors.New("execution is not paused")
+	}
+
+	nodeOutputs, err := w.collectPartialOutputs(ctx, wfExe)
+	if err != nil {
+		return nil, err
+	}
+
+	globalVariables, err := w.collectGlobalVariables(ctx, wfExe)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflow.InspectVariablesResponse{
+		Data: &workflow.InspectVariablesData{
+			NodeOutputs:     nodeOutputs,
+			GlobalVariables: globalVariables,
+		},
+	}, nil
+}
+
+// collectGlobalVariables fetches the current values of the global variables (user, app and
+// system channels) referenced anywhere in wfExe's workflow, keyed by variable name, as a JSON
+// object string. It reuses the per-node IsRefGlobalVariable flag that QueryWorkflowNodeTypes
+// surfaces to skip the fetch entirely when the workflow references no global variable.
+func (w *ApplicationService) collectGlobalVariables(ctx context.Context, wfExe *entity.WorkflowExecution) (*string, error) {
+	nodeProperties, err := GetWorkflowDomainSVC().QueryNodeProperties(ctx, wfExe.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	var refsGlobalVariable bool
+	for _, nodeProp := range nodeProperties {
+		if nodeProp.IsRefGlobalVariable {
+			refsGlobalVariable = true
+			break
+		}
+	}
+
+	if !refsGlobalVariable {
+		return nil, nil
+	}
+
+	var bizID string
+	var bizType project_memory.VariableConnector
+	if wfExe.AppID != nil {
+		bizID = strconv.FormatInt(*wfExe.AppID, 10)
+		bizType = project_memory.VariableConnector_Project
+	} else if wfExe.AgentID != nil {
+		bizID = strconv.FormatInt(*wfExe.AgentID, 10)
+		bizType = project_memory.VariableConnector_Bot
+	} else {
+		return nil, nil
+	}
+
+	meta := &variablesModel.UserVariableMeta{
+		BizType:      bizType,
+		BizID:        bizID,
+		ConnectorID:  wfExe.ConnectorID,
+		ConnectorUID: wfExe.ConnectorUID,
+	}
+
+	values := make(map[string]string)
+	for _, channel := range []project_memory.VariableChannel{
+		project_memory.VariableChannel_Custom,
+		project_memory.VariableChannel_APP,
+		project_memory.VariableChannel_System,
+	} {
+		kvItems, err := crossvariables.DefaultSVC().GetVariableChannelInstance(ctx, meta, nil, project_memory.VariableChannelPtr(channel))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range kvItems {
+			values[item.GetKeyword()] = item.GetValue()
+		}
+	}
+
+	data, err := sonic.MarshalString(values)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &workflow.GetWorkflowReferencesResponse{}
-	response.Data = &workflow.WorkflowReferencesData{
-		WorkflowList: make([]*workflow.Workflow, 0, len(workflows)),
-	}
-	for id, wk := range workflows {
-		wfw := &workflow.Workflow{
-			WorkflowID:       strconv.FormatInt(id, 10),
-			Name:             wk.Name,
-			Desc:             wk.Desc,
-			URL:              wk.IconURL,
-			IconURI:          wk.IconURI,
-			Status:           workflow.WorkFlowDevStatus_HadSubmit,
-			CreateTime:       wk.CreatedAt.Unix(),
-			Tag:              wk.Tag,
-			TemplateAuthorID: ptr.Of(strconv.FormatInt(wk.AuthorID, 10)),
-			SpaceID:          ptr.Of(strconv.FormatInt(wk.SpaceID, 10)),
-			Creator: &workflow.Creator{
-				ID: strconv.FormatInt(wk.CreatorID, 10),
-			},
-			FlowMode: wk.Mode,
-		}
+	return ptr.Of(data), nil
+}
 
-		if wk.UpdatedAt != nil {
-			wfw.UpdateTime = wk.UpdatedAt.Unix()
-		}
+// batchCSVErrorColumn is always present as the last CSV column, holding the sub-execution's
+// error message (if any), so a failed row is still exportable alongside successful ones.
+const batchCSVErrorColumn = "error"
 
-		if wk.AppID != nil {
-			wfw.ProjectID = ptr.Of(strconv.FormatInt(ptr.From(wk.AppID), 10))
-		}
+func (w *ApplicationService) ExportNodeBatchCSV(ctx context.Context, req *workflow.ExportNodeBatchCSVRequest) (
+	_ *workflow.ExportNodeBatchCSVResponse, err error,
+) {
+	nodeExe, _, err := GetWorkflowDomainSVC().GetNodeExecution(ctx, mustParseInt64(req.GetExecuteID()), req.GetNodeID())
+	if err != nil {
+		return nil, err
+	}
 
-		response.Data.WorkflowList = append(response.Data.WorkflowList, wfw)
+	if len(nodeExe.IndexedExecutions) == 0 {
+		return nil, fmt.Errorf("node %s in execution %s has no batch sub-executions", req.GetNodeID(), req.GetExecuteID())
 	}
 
-	return response, nil
+	csvStr, err := batchNodeExecutionsToCSV(nodeExe.IndexedExecutions, req.GetColumns())
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflow.ExportNodeBatchCSVResponse{
+		Data: &workflow.ExportNodeBatchCSVData{
+			Csv: ptr.Of(csvStr),
+		},
+	}, nil
 }
 
-func (w *ApplicationService) TestResume(ctx context.Context, req *workflow.WorkflowTestResumeRequest) (
-	_ *workflow.WorkflowTestResumeResponse, err error,
-) {
-	defer func() {
-		if panicErr := recover(); panicErr != nil {
-			err = safego.NewPanicErr(panicErr, debug.Stack())
+// batchNodeExecutionsToCSV flattens a batch node's sub-executions into CSV rows. If columns is
+// empty, the column set is discovered from every successful sub-execution's output keys, in
+// first-seen order. A sub-execution that failed, or is missing entirely, gets a row with only
+// the error column populated.
+func batchNodeExecutionsToCSV(subs []*entity.NodeExecution, columns []string) (string, error) {
+	seen := make(map[string]struct{}, len(columns)+1)
+	ordered := make([]string, 0, len(columns)+1)
+	for _, c := range columns {
+		if _, ok := seen[c]; ok {
+			continue
 		}
+		seen[c] = struct{}{}
+		ordered = append(ordered, c)
+	}
+	autoDetect := len(columns) == 0
 
-		if err != nil {
-			err = vo.WrapIfNeeded(errno.ErrWorkflowExecuteFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+	rows := make([]map[string]string, len(subs))
+	for idx, sub := range subs {
+		row := make(map[string]string)
+		switch {
+		case sub == nil:
+			row[batchCSVErrorColumn] = "sub-execution missing"
+		case sub.Status == entity.NodeFailed:
+			row[batchCSVErrorColumn] = ptr.FromOrDefault(sub.ErrorInfo, "unknown error")
+		default:
+			if sub.Output != nil && len(*sub.Output) > 0 {
+				var out map[string]any
+				if err := sonic.UnmarshalString(*sub.Output, &out); err != nil {
+					return "", vo.WrapError(errno.ErrSchemaConversionFail, err)
+				}
+				for k, v := range out {
+					if autoDetect {
+						if _, ok := seen[k]; !ok {
+							seen[k] = struct{}{}
+							ordered = append(ordered, k)
+						}
+					}
+					row[k] = stringifyCSVCell(v)
+				}
+			}
 		}
-	}()
+		rows[idx] = row
+	}
 
-	if err := checkUserSpace(ctx, ctxutil.MustGetUIDFromCtx(ctx), mustParseInt64(req.GetSpaceID())); err != nil {
-		return nil, err
+	if _, ok := seen[batchCSVErrorColumn]; !ok {
+		ordered = append(ordered, batchCSVErrorColumn)
 	}
 
-	resumeReq := &entity.ResumeRequest{
-		ExecuteID:  mustParseInt64(req.GetExecuteID()),
-		EventID:    mustParseInt64(req.GetEventID()),
-		ResumeData: req.GetData(),
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	if err := cw.Write(ordered); err != nil {
+		return "", err
 	}
-	err = GetWorkflowDomainSVC().AsyncResume(ctx, resumeReq, workflowModel.ExecuteConfig{
-		Operator:    ptr.FromOrDefault(ctxutil.GetUIDFromCtx(ctx), 0),
-		Mode:        workflowModel.ExecuteModeDebug, // at this stage it could be debug or node debug, we will decide it within AsyncResume
-		BizType:     workflowModel.BizTypeWorkflow,
-		Cancellable: true,
-	})
-	if err != nil {
-		return nil, err
+	for _, row := range rows {
+		record := make([]string, len(ordered))
+		for i, col := range ordered {
+			record[i] = row[col]
+		}
+		if err := cw.Write(record); err != nil {
+			return "", err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return "", err
 	}
 
-	return &workflow.WorkflowTestResumeResponse{}, nil
+	return buf.String(), nil
+}
+
+func stringifyCSVCell(v any) string {
+	switch vv := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return vv
+	default:
+		s, err := sonic.MarshalString(vv)
+		if err != nil {
+			return fmt.Sprintf("%v", vv)
+		}
+		return s
+	}
 }
 
 func (w *ApplicationService) Cancel(ctx context.Context, req *workflow.CancelWorkFlowRequest) (
@@ -2164,6 +4057,9 @@ func (w *ApplicationService) PublishWorkflow(ctx context.Context, req *workflow.
 		if err != nil {
 			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
 		}
+
+		audit.Record(ctx, ptr.FromOrDefault(ctxutil.GetUIDFromCtx(ctx), 0), "publish_workflow",
+			mustParseInt64(req.GetWorkflowID()), mustParseInt64(req.GetSpaceID()), err)
 	}()
 
 	userID := ctxutil.MustGetUIDFromCtx(ctx)
@@ -2185,6 +4081,8 @@ func (w *ApplicationService) PublishWorkflow(ctx context.Context, req *workflow.
 		return nil, err
 	}
 
+	invalidatePluginParamCache(info.ID)
+
 	return &workflow.PublishWorkflowResponse{
 		Data: &workflow.PublishWorkflowData{
 			WorkflowID: req.GetWorkflowID(),
@@ -2440,7 +4338,10 @@ func (w *ApplicationService) GetWorkflowDetail(ctx context.Context, req *workflo
 
 		wd.EndType, err = parseWorkflowTerminatePlanType(cv)
 		if err != nil {
-			return nil, err
+			// A workflow missing an end node (e.g. still mid-edit) shouldn't fail the whole
+			// batch; skip it and keep returning details for the rest.
+			logs.CtxWarnf(ctx, "[GetWorkflowDetail] failed to parse terminate plan type, workflowID=%s, err=%v", wfIDStr, err)
+			continue
 		}
 
 		if wf.AppID != nil {
@@ -2507,105 +4408,161 @@ func (w *ApplicationService) GetWorkflowDetailInfo(ctx context.Context, req *wor
 		return &vo.WorkflowDetailInfoDataList{}, nil
 	}
 
-	var wfs []*entity.Workflow
-	if len(draftIDs) > 0 {
-		wfs, _, err = GetWorkflowDomainSVC().MGet(ctx, &vo.MGetPolicy{
-			MetaQuery: vo.MetaQuery{
-				IDs: draftIDs,
-			},
-			QType:    workflowModel.FromDraft,
-			MetaOnly: false,
-		})
-		if err != nil {
-			return nil, err
+	var (
+		draftWfs, versionWfs []*entity.Workflow
+		wfs                  []*entity.Workflow
+	)
+	{
+		eg, gCtx := errgroup.WithContext(ctx)
+		if len(draftIDs) > 0 {
+			eg.Go(func() error {
+				var gErr error
+				draftWfs, _, gErr = GetWorkflowDomainSVC().MGet(gCtx, &vo.MGetPolicy{
+					MetaQuery: vo.MetaQuery{
+						IDs: draftIDs,
+					},
+					QType:    workflowModel.FromDraft,
+					MetaOnly: false,
+				})
+				return gErr
+			})
 		}
-	}
-
-	if len(versionIDs) > 0 {
-		versionWfs, _, err := GetWorkflowDomainSVC().MGet(ctx, &vo.MGetPolicy{
-			MetaQuery: vo.MetaQuery{
-				IDs: versionIDs,
-			},
-			QType:    workflowModel.FromSpecificVersion,
-			MetaOnly: false,
-			Versions: id2Version,
-		})
-		if err != nil {
+		if len(versionIDs) > 0 {
+			eg.Go(func() error {
+				var gErr error
+				versionWfs, _, gErr = GetWorkflowDomainSVC().MGet(gCtx, &vo.MGetPolicy{
+					MetaQuery: vo.MetaQuery{
+						IDs: versionIDs,
+					},
+					QType:    workflowModel.FromSpecificVersion,
+					MetaOnly: false,
+					Versions: id2Version,
+				})
+				return gErr
+			})
+		}
+		if err = eg.Wait(); err != nil {
 			return nil, err
 		}
-		wfs = append(wfs, versionWfs...)
+		wfs = append(draftWfs, versionWfs...)
 	}
 
-	workflowDetailInfoDataList := &vo.WorkflowDetailInfoDataList{
-		List: make([]*workflow.WorkflowDetailInfoData, 0, len(wfs)),
+	// requestedFields, when non-empty, restricts computation to those WorkflowDetailInfoData
+	// fields, skipping canvas parsing and toVariables conversion for fields the caller doesn't
+	// need. An empty selector means "compute everything", matching pre-existing behavior.
+	requestedFields := req.GetFields()
+	wantsField := func(name string) bool {
+		return len(requestedFields) == 0 || slices.Contains(requestedFields, name)
 	}
-	inputs := make(map[string]any)
-	outputs := make(map[string]any)
-	for _, wf := range wfs {
-		wfIDStr := strconv.FormatInt(wf.ID, 10)
-		wd := &workflow.WorkflowDetailInfoData{
-			WorkflowID: wfIDStr,
-			Name:       wf.Name,
-			Desc:       wf.Desc,
-			SpaceID:    strconv.FormatInt(wf.SpaceID, 10),
-			CreateTime: wf.CreatedAt.Unix(),
-			IconURI:    wf.IconURI,
-			Icon:       wf.IconURL,
-			FlowMode:   wf.Mode,
-			Creator: &workflow.Creator{
-				ID:   strconv.FormatInt(wf.CreatorID, 10),
-				Self: ternary.IFElse[bool](wf.CreatorID == ptr.From(ctxutil.GetUIDFromCtx(ctx)), true, false),
-			},
+	needEndType := wantsField("end_type") || wantsField("outputs")
+	needInputs := wantsField("inputs")
+	needOutputs := wantsField("outputs")
 
-			LatestFlowVersion: wf.GetLatestVersion(),
-		}
+	// wds and the input/output maps are built per-workflow in parallel below, then stitched
+	// back together in MGet's original order.
+	wds := make([]*workflow.WorkflowDetailInfoData, len(wfs))
+	wfInputs := make([]any, len(wfs))
+	wfOutputs := make([]any, len(wfs))
 
-		if wf.VersionMeta != nil {
-			wd.FlowVersion = wf.Version
-			wd.FlowVersionDesc = wf.VersionDescription
-		}
+	eg, gCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(workflowDetailInfoConcurrency)
+	for i, wf := range wfs {
+		i, wf := i, wf
+		eg.Go(func() error {
+			wfIDStr := strconv.FormatInt(wf.ID, 10)
+			wd := &workflow.WorkflowDetailInfoData{
+				WorkflowID: wfIDStr,
+				Name:       wf.Name,
+				Desc:       wf.Desc,
+				SpaceID:    strconv.FormatInt(wf.SpaceID, 10),
+				CreateTime: wf.CreatedAt.Unix(),
+				IconURI:    wf.IconURI,
+				Icon:       wf.IconURL,
+				FlowMode:   wf.Mode,
+				Creator: &workflow.Creator{
+					ID:   strconv.FormatInt(wf.CreatorID, 10),
+					Self: ternary.IFElse[bool](wf.CreatorID == ptr.From(ctxutil.GetUIDFromCtx(gCtx)), true, false),
+				},
 
-		cv := &vo.Canvas{}
-		err = sonic.UnmarshalString(wf.Canvas, cv)
-		if err != nil {
-			return nil, err
-		}
+				LatestFlowVersion: wf.GetLatestVersion(),
+			}
 
-		wd.EndType, err = parseWorkflowTerminatePlanType(cv)
-		if err != nil {
-			return nil, err
-		}
+			if wf.VersionMeta != nil {
+				wd.FlowVersion = wf.Version
+				wd.FlowVersionDesc = wf.VersionDescription
+			}
 
-		if wf.DraftMeta != nil {
-			wd.UpdateTime = wf.DraftMeta.Timestamp.Unix()
-		} else if wf.VersionMeta != nil {
-			wd.UpdateTime = wf.VersionMeta.VersionCreatedAt.Unix()
-		} else if wf.UpdatedAt != nil {
-			wd.UpdateTime = wf.UpdatedAt.Unix()
-		}
+			if needEndType {
+				cv := &vo.Canvas{}
+				if err := sonic.UnmarshalString(wf.Canvas, cv); err != nil {
+					return err
+				}
 
-		if wf.AppID != nil {
-			wd.ProjectID = strconv.FormatInt(*wf.AppID, 10)
-		}
+				var err error
+				wd.EndType, err = parseWorkflowTerminatePlanType(cv)
+				if err != nil {
+					return err
+				}
+			}
 
-		inputs[wfIDStr], err = toVariables(wf.InputParams)
-		if err != nil {
-			return nil, err
-		}
+			if wf.DraftMeta != nil {
+				wd.UpdateTime = wf.DraftMeta.Timestamp.Unix()
+			} else if wf.VersionMeta != nil {
+				wd.UpdateTime = wf.VersionMeta.VersionCreatedAt.Unix()
+			} else if wf.UpdatedAt != nil {
+				wd.UpdateTime = wf.UpdatedAt.Unix()
+			}
 
-		if wd.EndType == 1 {
-			outputs[wfIDStr] = []*vo.Variable{
-				{
-					Name: "output",
-					Type: vo.VariableTypeString,
-				},
+			if wf.AppID != nil {
+				wd.ProjectID = strconv.FormatInt(*wf.AppID, 10)
 			}
-		} else {
-			outputs[wfIDStr], err = toVariables(wf.OutputParams)
-			if err != nil {
-				return nil, err
+
+			var in any
+			if needInputs {
+				var err error
+				in, err = toVariables(wf.InputParams)
+				if err != nil {
+					return err
+				}
 			}
-		}
+
+			var out any
+			if needOutputs {
+				var err error
+				if wd.EndType == 1 {
+					out = []*vo.Variable{
+						{
+							Name: "output",
+							Type: vo.VariableTypeString,
+						},
+					}
+				} else {
+					out, err = toVariables(wf.OutputParams)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			wds[i] = wd
+			wfInputs[i] = in
+			wfOutputs[i] = out
+			return nil
+		})
+	}
+	if err = eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	workflowDetailInfoDataList := &vo.WorkflowDetailInfoDataList{
+		List: make([]*workflow.WorkflowDetailInfoData, 0, len(wfs)),
+	}
+	inputs := make(map[string]any)
+	outputs := make(map[string]any)
+	for i, wd := range wds {
+		wfIDStr := wd.WorkflowID
+		inputs[wfIDStr] = wfInputs[i]
+		outputs[wfIDStr] = wfOutputs[i]
 		workflowDetailInfoDataList.List = append(workflowDetailInfoDataList.List, wd)
 	}
 	workflowDetailInfoDataList.Inputs = inputs
@@ -2613,6 +4570,51 @@ func (w *ApplicationService) GetWorkflowDetailInfo(ctx context.Context, req *wor
 	return workflowDetailInfoDataList, nil
 }
 
+// uploadSceneConfig describes the upload constraints for a single GetWorkflowUploadAuthToken
+// scene: the TOS object key prefix its assets are stored under, and the limits the frontend
+// should enforce before uploading.
+type uploadSceneConfig struct {
+	prefix              string
+	maxSizeBytes        int64
+	allowedContentTypes []string
+}
+
+// sceneToUploadConfigMap configures the upload constraints for each scene GetWorkflowUploadAuthToken
+// supports. Add an entry here when workflows start uploading a new kind of asset.
+var sceneToUploadConfigMap = map[string]uploadSceneConfig{
+	"imageflow": {
+		prefix:              "imageflow-",
+		maxSizeBytes:        10 * 1024 * 1024,
+		allowedContentTypes: []string{"image/jpeg", "image/png", "image/gif", "image/webp"},
+	},
+	"audio": {
+		prefix:              "audio-",
+		maxSizeBytes:        50 * 1024 * 1024,
+		allowedContentTypes: []string{"audio/mpeg", "audio/wav", "audio/x-wav", "audio/ogg"},
+	},
+	"document": {
+		prefix:              "document-",
+		maxSizeBytes:        20 * 1024 * 1024,
+		allowedContentTypes: []string{"application/pdf", "text/plain", "application/msword", "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+	},
+	"video": {
+		prefix:              "video-",
+		maxSizeBytes:        200 * 1024 * 1024,
+		allowedContentTypes: []string{"video/mp4", "video/quicktime", "video/x-msvideo"},
+	},
+}
+
+// supportedUploadScenes returns the keys of sceneToUploadConfigMap in sorted order, for use in
+// error messages shown when an unknown scene is requested.
+func supportedUploadScenes() []string {
+	scenes := make([]string, 0, len(sceneToUploadConfigMap))
+	for scene := range sceneToUploadConfigMap {
+		scenes = append(scenes, scene)
+	}
+	sort.Strings(scenes)
+	return scenes
+}
+
 func (w *ApplicationService) GetWorkflowUploadAuthToken(ctx context.Context, req *workflow.GetUploadAuthTokenRequest) (
 	_ *workflow.GetUploadAuthTokenResponse, err error,
 ) {
@@ -2626,16 +4628,10 @@ func (w *ApplicationService) GetWorkflowUploadAuthToken(ctx context.Context, req
 		}
 	}()
 
-	var (
-		sceneToUploadPrefixMap = map[string]string{
-			"imageflow": "imageflow-",
-		}
-		prefix string
-		ok     bool
-	)
-
-	if prefix, ok = sceneToUploadPrefixMap[req.GetScene()]; !ok {
-		return nil, fmt.Errorf("scene %s is not supported", req.GetScene())
+	sceneConfig, ok := sceneToUploadConfigMap[req.GetScene()]
+	if !ok {
+		return nil, fmt.Errorf("scene %s is not supported, supported scenes are: %s",
+			req.GetScene(), strings.Join(supportedUploadScenes(), ", "))
 	}
 
 	authToken, err := w.getAuthToken(ctx)
@@ -2646,7 +4642,7 @@ func (w *ApplicationService) GetWorkflowUploadAuthToken(ctx context.Context, req
 	return &workflow.GetUploadAuthTokenResponse{
 		Data: &workflow.GetUploadAuthTokenData{
 			ServiceID:        authToken.ServiceID,
-			UploadPathPrefix: prefix,
+			UploadPathPrefix: sceneConfig.prefix,
 			UploadHost:       authToken.UploadHost,
 			Auth: &workflow.UploadAuthTokenInfo{
 				AccessKeyID:     authToken.AccessKeyID,
@@ -2655,7 +4651,9 @@ func (w *ApplicationService) GetWorkflowUploadAuthToken(ctx context.Context, req
 				ExpiredTime:     authToken.ExpiredTime,
 				CurrentTime:     authToken.CurrentTime,
 			},
-			Schema: authToken.HostScheme,
+			Schema:              authToken.HostScheme,
+			MaxSizeBytes:        ptr.Of(sceneConfig.maxSizeBytes),
+			AllowedContentTypes: sceneConfig.allowedContentTypes,
 		},
 	}, nil
 }
@@ -2691,13 +4689,13 @@ func (w *ApplicationService) SignImageURL(ctx context.Context, req *workflow.Sig
 		}
 	}()
 
-	url, err := w.ImageX.GetResourceURL(ctx, req.GetURI())
+	url, err := w.resolveImageURL(ctx, req.GetURI())
 	if err != nil {
 		return nil, err
 	}
 
 	return &workflow.SignImageURLResponse{
-		URL: url.URL,
+		URL: url,
 	}, nil
 }
 
@@ -2754,25 +4752,36 @@ func (w *ApplicationService) GetApiDetail(ctx context.Context, req *workflow.Get
 		return nil, err
 	}
 
+	apiDetailData := &workflow.ApiDetailData{
+		PluginID:            req.GetPluginID(),
+		SpaceID:             req.GetSpaceID(),
+		Icon:                toolInfoResponse.IconURL,
+		Name:                toolInfoResponse.PluginName,
+		Desc:                toolInfoResponse.Description,
+		ApiName:             toolInfo.ToolName,
+		Version:             &toolInfoResponse.Version,
+		VersionName:         &toolInfoResponse.Version,
+		PluginType:          workflow.PluginType(toolInfoResponse.PluginType),
+		LatestVersionName:   toolInfoResponse.LatestVersion,
+		LatestVersion:       toolInfoResponse.LatestVersion,
+		PluginProductStatus: ternary.IFElse(toolInfoResponse.IsOfficial, int64(1), 0),
+		ProjectID:           ternary.IFElse(toolInfoResponse.AppID != 0, ptr.Of(strconv.FormatInt(toolInfoResponse.AppID, 10)), nil),
+		PluginFrom:          req.PluginFrom,
+	}
+
+	if productTool, ok := pluginConf.GetToolProduct(toolID); ok {
+		if productTool.Info.RateLimitPerSecond != nil {
+			apiDetailData.RateLimitPerSecond = ptr.Of(int64(*productTool.Info.RateLimitPerSecond))
+		}
+		if productTool.Info.RateLimitPerMinute != nil {
+			apiDetailData.RateLimitPerMinute = ptr.Of(int64(*productTool.Info.RateLimitPerMinute))
+		}
+	}
+
 	toolDetailInfo := &vo.ToolDetailInfo{
-		ApiDetailData: &workflow.ApiDetailData{
-			PluginID:            req.GetPluginID(),
-			SpaceID:             req.GetSpaceID(),
-			Icon:                toolInfoResponse.IconURL,
-			Name:                toolInfoResponse.PluginName,
-			Desc:                toolInfoResponse.Description,
-			ApiName:             toolInfo.ToolName,
-			Version:             &toolInfoResponse.Version,
-			VersionName:         &toolInfoResponse.Version,
-			PluginType:          workflow.PluginType(toolInfoResponse.PluginType),
-			LatestVersionName:   toolInfoResponse.LatestVersion,
-			LatestVersion:       toolInfoResponse.LatestVersion,
-			PluginProductStatus: ternary.IFElse(toolInfoResponse.IsOfficial, int64(1), 0),
-			ProjectID:           ternary.IFElse(toolInfoResponse.AppID != 0, ptr.Of(strconv.FormatInt(toolInfoResponse.AppID, 10)), nil),
-			PluginFrom:          req.PluginFrom,
-		},
-		ToolInputs:  inputVars,
-		ToolOutputs: outputVars,
+		ApiDetailData: apiDetailData,
+		ToolInputs:    inputVars,
+		ToolOutputs:   outputVars,
 	}
 
 	return toolDetailInfo, nil
@@ -2801,6 +4810,7 @@ func (w *ApplicationService) GetLLMNodeFCSettingDetail(ctx context.Context, req
 		toolsDetailInfo     = make(map[string]*workflow.APIDetail)
 		workflowDetailMap   = make(map[string]*workflow.WorkflowDetail)
 		knowledgeDetailMap  = make(map[string]*workflow.DatasetDetail)
+		missingReferences   []string
 	)
 
 	if len(req.GetPluginList()) > 0 {
@@ -2872,6 +4882,12 @@ func (w *ApplicationService) GetLLMNodeFCSettingDetail(ctx context.Context, req
 			}
 
 		}
+
+		for _, pl := range req.GetPluginList() {
+			if _, ok := toolsDetailInfo[pl.APIID]; !ok {
+				missingReferences = append(missingReferences, fmt.Sprintf("plugin:%s:tool:%s", pl.PluginID, pl.APIID))
+			}
+		}
 	}
 
 	if len(req.GetWorkflowList()) > 0 {
@@ -2947,6 +4963,12 @@ func (w *ApplicationService) GetLLMNodeFCSettingDetail(ctx context.Context, req
 				},
 			}
 		}
+
+		for _, wf := range req.GetWorkflowList() {
+			if _, ok := workflowDetailMap[wf.WorkflowID]; !ok {
+				missingReferences = append(missingReferences, fmt.Sprintf("workflow:%s", wf.WorkflowID))
+			}
+		}
 	}
 
 	if len(req.GetDatasetList()) > 0 {
@@ -2963,13 +4985,21 @@ func (w *ApplicationService) GetLLMNodeFCSettingDetail(ctx context.Context, req
 		}
 		knowledgeDetailMap = slices.ToMap(details.KnowledgeDetails, func(kd *model.KnowledgeDetail) (string, *workflow.DatasetDetail) {
 			return strconv.FormatInt(kd.ID, 10), &workflow.DatasetDetail{
-				ID:         strconv.FormatInt(kd.ID, 10),
-				Name:       kd.Name,
-				IconURL:    kd.IconURL,
-				FormatType: kd.FormatType,
+				ID:            strconv.FormatInt(kd.ID, 10),
+				Name:          kd.Name,
+				IconURL:       kd.IconURL,
+				FormatType:    kd.FormatType,
+				DocumentCount: kd.DocumentCount,
+				TotalSize:     kd.TotalSize,
+				IsIndexing:    kd.IsIndexing,
 			}
 		})
 
+		for _, ds := range req.GetDatasetList() {
+			if _, ok := knowledgeDetailMap[ds.GetDatasetID()]; !ok {
+				missingReferences = append(missingReferences, fmt.Sprintf("dataset:%s", ds.GetDatasetID()))
+			}
+		}
 	}
 
 	response := &workflow.GetLLMNodeFCSettingDetailResponse{
@@ -2977,6 +5007,7 @@ func (w *ApplicationService) GetLLMNodeFCSettingDetail(ctx context.Context, req
 		PluginAPIDetailMap: toolsDetailInfo,
 		WorkflowDetailMap:  workflowDetailMap,
 		DatasetDetailMap:   knowledgeDetailMap,
+		MissingReferences:  missingReferences,
 	}
 
 	return &GetLLMNodeFCSettingDetailResponse{
@@ -3038,8 +5069,8 @@ func (w *ApplicationService) GetLLMNodeFCSettingsMerged(ctx context.Context, req
 
 		latestRequestParams := toolInfo.Inputs
 		latestResponseParams := toolInfo.Outputs
-		mergeWorkflowAPIParameters(latestRequestParams, pluginFcSetting.GetRequestParams())
-		mergeWorkflowAPIParameters(latestResponseParams, pluginFcSetting.GetResponseParams())
+		latestRequestParams = mergeWorkflowAPIParameters(latestRequestParams, pluginFcSetting.GetRequestParams())
+		latestResponseParams = mergeWorkflowAPIParameters(latestResponseParams, pluginFcSetting.GetResponseParams())
 
 		fcPluginSetting = &workflow.FCPluginSetting{
 			PluginID:       strconv.FormatInt(pInfo.PluginID, 10),
@@ -3049,7 +5080,7 @@ func (w *ApplicationService) GetLLMNodeFCSettingsMerged(ctx context.Context, req
 			RequestParams:  latestRequestParams,
 			ResponseParams: latestResponseParams,
 			PluginVersion:  pluginFcSetting.GetPluginVersion(),
-			ResponseStyle:  &workflow.ResponseStyle{},
+			ResponseStyle:  responseStyleOrDefault(pluginFcSetting.GetResponseStyle()),
 		}
 	}
 	var fCWorkflowSetting *workflow.FCWorkflowSetting
@@ -3080,9 +5111,9 @@ func (w *ApplicationService) GetLLMNodeFCSettingsMerged(ctx context.Context, req
 			return nil, err
 		}
 
-		mergeWorkflowAPIParameters(latestRequestParams, setting.GetRequestParams())
+		latestRequestParams = mergeWorkflowAPIParameters(latestRequestParams, setting.GetRequestParams())
 
-		mergeWorkflowAPIParameters(latestResponseParams, setting.GetResponseParams())
+		latestResponseParams = mergeWorkflowAPIParameters(latestResponseParams, setting.GetResponseParams())
 
 		fCWorkflowSetting = &workflow.FCWorkflowSetting{
 			WorkflowID:     strconv.FormatInt(wID, 10),
@@ -3090,14 +5121,82 @@ func (w *ApplicationService) GetLLMNodeFCSettingsMerged(ctx context.Context, req
 			IsDraft:        setting.GetIsDraft(),
 			RequestParams:  latestRequestParams,
 			ResponseParams: latestResponseParams,
-			ResponseStyle:  &workflow.ResponseStyle{},
+			ResponseStyle:  responseStyleOrDefault(setting.GetResponseStyle()),
+		}
+	}
+
+	return &workflow.GetLLMNodeFCSettingsMergedResponse{
+		PluginFcSetting:  fcPluginSetting,
+		WorflowFcSetting: fCWorkflowSetting,
+	}, nil
+}
+
+// responseStyleOrDefault preserves the caller's stored response style instead of discarding it;
+// ResponseStyle is a required field on the thrift response, so an unset style still needs a value.
+func responseStyleOrDefault(style *workflow.ResponseStyle) *workflow.ResponseStyle {
+	if style == nil {
+		return &workflow.ResponseStyle{}
+	}
+	return style
+}
+
+// effectiveAPIParameters materializes the parameter set the LLM will actually see: parameters
+// disabled via GlobalDisable/LocalDisable are dropped, and the remaining parameters' Value is
+// filled from LocalDefault (falling back to GlobalDefault) when not already set.
+func effectiveAPIParameters(params []*workflow.APIParameter) []*workflow.APIParameter {
+	effective := make([]*workflow.APIParameter, 0, len(params))
+	for _, p := range params {
+		if p.GlobalDisable || p.LocalDisable {
+			continue
+		}
+
+		cp := *p
+		if cp.Value == nil {
+			if cp.LocalDefault != nil {
+				cp.Value = cp.LocalDefault
+			} else if cp.GlobalDefault != nil {
+				cp.Value = cp.GlobalDefault
+			}
+		}
+
+		if len(cp.SubParameters) > 0 {
+			cp.SubParameters = effectiveAPIParameters(cp.SubParameters)
+		}
+
+		effective = append(effective, &cp)
+	}
+	return effective
+}
+
+// PreviewEffectiveToolSchema reuses GetLLMNodeFCSettingsMerged to compute the merged schema, then
+// materializes the effective view the LLM will actually see (disabled params removed, defaults
+// injected as Value), so users can verify their overrides before saving.
+func (w *ApplicationService) PreviewEffectiveToolSchema(ctx context.Context, req *workflow.GetLLMNodeFCSettingsMergedRequest) (
+	_ *workflow.GetLLMNodeFCSettingsMergedResponse, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
 		}
+	}()
+
+	merged, err := w.GetLLMNodeFCSettingsMerged(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if merged.PluginFcSetting != nil {
+		merged.PluginFcSetting.RequestParams = effectiveAPIParameters(merged.PluginFcSetting.RequestParams)
+	}
+	if merged.WorflowFcSetting != nil {
+		merged.WorflowFcSetting.RequestParams = effectiveAPIParameters(merged.WorflowFcSetting.RequestParams)
 	}
 
-	return &workflow.GetLLMNodeFCSettingsMergedResponse{
-		PluginFcSetting:  fcPluginSetting,
-		WorflowFcSetting: fCWorkflowSetting,
-	}, nil
+	return merged, nil
 }
 
 func (w *ApplicationService) GetPlaygroundPluginList(ctx context.Context, req *pluginAPI.GetPlaygroundPluginListRequest) (
@@ -3121,6 +5220,7 @@ func (w *ApplicationService) GetPlaygroundPluginList(ctx context.Context, req *p
 	var (
 		toolIDs []int64
 		wfs     []*entity.Workflow
+		total   int64
 	)
 	if len(req.GetPluginIds()) > 0 {
 		toolIDs, err = slices.TransformWithErrorCheck(req.GetPluginIds(), func(a string) (int64, error) {
@@ -3130,23 +5230,27 @@ func (w *ApplicationService) GetPlaygroundPluginList(ctx context.Context, req *p
 			return nil, err
 		}
 
-		wfs, _, err = GetWorkflowDomainSVC().MGet(ctx, &vo.MGetPolicy{
+		wfs, total, err = GetWorkflowDomainSVC().MGet(ctx, &vo.MGetPolicy{
 			MetaQuery: vo.MetaQuery{
-				IDs:           toolIDs,
-				SpaceID:       ptr.Of(req.GetSpaceID()),
-				PublishStatus: ptr.Of(vo.HasPublished),
+				IDs:             toolIDs,
+				SpaceID:         ptr.Of(req.GetSpaceID()),
+				PublishStatus:   ptr.Of(vo.HasPublished),
+				Mode:            ptr.Of(workflowModel.WorkflowMode(workflow.WorkflowMode_Workflow)),
+				NeedTotalNumber: true,
 			},
 			QType: workflowModel.FromLatestVersion,
 		})
 	} else if req.GetPage() > 0 && req.GetSize() > 0 {
-		wfs, _, err = GetWorkflowDomainSVC().MGet(ctx, &vo.MGetPolicy{
+		wfs, total, err = GetWorkflowDomainSVC().MGet(ctx, &vo.MGetPolicy{
 			MetaQuery: vo.MetaQuery{
 				Page: &vo.Page{
 					Size: req.GetSize(),
 					Page: req.GetPage(),
 				},
-				SpaceID:       ptr.Of(req.GetSpaceID()),
-				PublishStatus: ptr.Of(vo.HasPublished),
+				SpaceID:         ptr.Of(req.GetSpaceID()),
+				PublishStatus:   ptr.Of(vo.HasPublished),
+				Mode:            ptr.Of(workflowModel.WorkflowMode(workflow.WorkflowMode_Workflow)),
+				NeedTotalNumber: true,
 			},
 			QType: workflowModel.FromLatestVersion,
 		})
@@ -3166,10 +5270,11 @@ func (w *ApplicationService) GetPlaygroundPluginList(ctx context.Context, req *p
 			Creator: &common.Creator{
 				Self: wf.CreatorID == currentUser,
 			},
-			PluginType:  common.PluginType_WORKFLOW,
-			VersionName: wf.VersionMeta.Version,
-			CreateTime:  strconv.FormatInt(wf.CreatedAt.Unix(), 10),
-			UpdateTime:  strconv.FormatInt(wf.VersionCreatedAt.Unix(), 10),
+			PluginType:     common.PluginType_WORKFLOW,
+			VersionName:    wf.VersionMeta.Version,
+			CreateTime:     strconv.FormatInt(wf.CreatedAt.Unix(), 10),
+			UpdateTime:     strconv.FormatInt(wf.VersionCreatedAt.Unix(), 10),
+			QualityWarning: inputParamsQualityWarning(wf.InputParams),
 		}
 
 		pluginApi := &common.PluginApi{
@@ -3178,7 +5283,7 @@ func (w *ApplicationService) GetPlaygroundPluginList(ctx context.Context, req *p
 			Desc:     wf.Desc,
 			PluginID: strconv.FormatInt(wf.ID, 10),
 		}
-		pluginApi.Parameters, err = slices.TransformWithErrorCheck(wf.InputParams, toPluginParameter)
+		pluginApi.Parameters, err = getPluginParameters(wf.ID, wf.VersionMeta.Version, wf.InputParams)
 		if err != nil {
 			return nil, err
 		}
@@ -3187,17 +5292,30 @@ func (w *ApplicationService) GetPlaygroundPluginList(ctx context.Context, req *p
 		pluginInfoList = append(pluginInfoList, pInfo)
 	}
 
+	data := &common.GetPlaygroundPluginListData{
+		PluginList: pluginInfoList,
+		Total:      int32(total),
+	}
+	if req.GetPage() > 0 {
+		data.Page = ptr.Of(req.GetPage())
+	}
+	if req.GetSize() > 0 {
+		data.Size = ptr.Of(req.GetSize())
+	}
+
 	return &pluginAPI.GetPlaygroundPluginListResponse{
-		Data: &common.GetPlaygroundPluginListData{
-			PluginList: pluginInfoList,
-			Total:      int32(len(pluginInfoList)),
-		},
+		Data: data,
 	}, nil
 }
 
 func (w *ApplicationService) CopyWorkflow(ctx context.Context, req *workflow.CopyWorkflowRequest) (
 	resp *workflow.CopyWorkflowResponse, err error,
 ) {
+	var (
+		spaceID    int64
+		workflowID int64
+	)
+
 	defer func() {
 		if panicErr := recover(); panicErr != nil {
 			err = safego.NewPanicErr(panicErr, debug.Stack())
@@ -3206,9 +5324,11 @@ func (w *ApplicationService) CopyWorkflow(ctx context.Context, req *workflow.Cop
 		if err != nil {
 			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
 		}
+
+		audit.Record(ctx, ptr.FromOrDefault(ctxutil.GetUIDFromCtx(ctx), 0), "copy_workflow", workflowID, spaceID, err)
 	}()
 
-	spaceID, err := strconv.ParseInt(req.GetSpaceID(), 10, 64)
+	spaceID, err = strconv.ParseInt(req.GetSpaceID(), 10, 64)
 	if err != nil {
 		return nil, err
 	}
@@ -3217,7 +5337,7 @@ func (w *ApplicationService) CopyWorkflow(ctx context.Context, req *workflow.Cop
 		return nil, err
 	}
 
-	workflowID, err := strconv.ParseInt(req.GetWorkflowID(), 10, 64)
+	workflowID, err = strconv.ParseInt(req.GetWorkflowID(), 10, 64)
 	if err != nil {
 		return nil, err
 	}
@@ -3229,6 +5349,8 @@ func (w *ApplicationService) CopyWorkflow(ctx context.Context, req *workflow.Cop
 		return nil, err
 	}
 
+	workflowID = wf.ID
+
 	return &workflow.CopyWorkflowResponse{
 		Data: &workflow.CopyWorkflowData{
 			WorkflowID: strconv.FormatInt(wf.ID, 10),
@@ -3343,6 +5465,12 @@ func (w *ApplicationService) GetExampleWorkFlowList(ctx context.Context, req *wo
 	if len(req.GetName()) > 0 {
 		option.Name = req.Name
 	}
+	if req.IsSetFlowMode() {
+		option.Mode = req.FlowMode
+	}
+	if req.IsSetCategory() {
+		option.Tag = req.Category
+	}
 
 	wfs, _, err := GetWorkflowDomainSVC().MGet(ctx, &vo.MGetPolicy{
 		MetaQuery: option,
@@ -3420,101 +5548,179 @@ func (w *ApplicationService) CopyWkTemplateApi(ctx context.Context, req *workflo
 	resp = &workflow.CopyWkTemplateApiResponse{
 		Data: map[int64]*workflow.WkPluginBasicData{},
 	}
-	for _, widStr := range req.GetWorkflowIds() {
-		wid, err := strconv.ParseInt(widStr, 10, 64)
-		if err != nil {
-			return nil, err
-		}
-		wf, err := w.copyWorkflow(ctx, wid, vo.CopyWorkflowPolicy{
-			ShouldModifyWorkflowName: false,
-			TargetSpaceID:            ptr.Of(req.GetTargetSpaceID()),
-			TargetAppID:              ptr.Of(int64(0)),
-		})
-		if err != nil {
-			return nil, err
-		}
 
-		err = w.publishWorkflowResource(ctx, &vo.PublishPolicy{
-			ID:        wf.ID,
-			Version:   "v0.0.0",
-			CommitID:  wf.CommitID,
-			CreatorID: ctxutil.MustGetUIDFromCtx(ctx),
-			Force:     true,
-		})
-		if err != nil {
-			return nil, err
-		}
-		var (
-			inputs    []*vo.NamedTypeInfo
-			outputs   []*vo.NamedTypeInfo
-			startNode *workflow.Node
-			endNode   *workflow.Node
-		)
-		if len(wf.InputParamsStr) > 0 {
-			err = sonic.UnmarshalString(wf.InputParamsStr, &inputs)
-			if err != nil {
-				return nil, err
-			}
-			startNode = &workflow.Node{
-				NodeID:    "100001",
-				NodeName:  "start-node",
-				NodeParam: &workflow.NodeParam{InputParameters: make([]*workflow.Parameter, 0, len(inputs))},
-			}
-			for _, in := range inputs {
-				param, err := toWorkflowParameter(in)
-				if err != nil {
-					return nil, err
-				}
-				startNode.NodeParam.InputParameters = append(startNode.NodeParam.InputParameters, param)
+	var (
+		mu        sync.Mutex
+		copiedIDs []int64
+	)
+
+	recordResult := func(widStr string, wid int64, data *workflow.WkPluginBasicData, copyErr error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if copyErr != nil {
+			if resp.FailedWorkflowIds == nil {
+				resp.FailedWorkflowIds = map[string]string{}
 			}
+			resp.FailedWorkflowIds[widStr] = copyErr.Error()
+			return
 		}
 
-		if len(wf.OutputParamsStr) > 0 {
-			err = sonic.UnmarshalString(wf.OutputParamsStr, &outputs)
-			if err != nil {
-				return nil, err
-			}
-			endNode = &workflow.Node{
-				NodeID:    entity.ExitNodeKey,
-				NodeName:  "end-node",
-				NodeParam: &workflow.NodeParam{InputParameters: make([]*workflow.Parameter, 0, len(outputs))},
-			}
-			for _, in := range outputs {
-				param, err := toWorkflowParameter(in)
+		copiedIDs = append(copiedIDs, data.WorkflowID)
+		resp.Data[wid] = data
+	}
+
+	if enableConcurrentTemplateCopy {
+		eg, gCtx := errgroup.WithContext(ctx)
+		eg.SetLimit(copyWkTemplateConcurrency)
+		for _, widStr := range req.GetWorkflowIds() {
+			widStr := widStr
+			eg.Go(func() error {
+				wid, err := strconv.ParseInt(widStr, 10, 64)
 				if err != nil {
-					return nil, err
+					recordResult(widStr, wid, nil, err)
+					return nil
 				}
-				endNode.NodeParam.InputParameters = append(endNode.NodeParam.InputParameters, param)
+
+				data, err := w.copyWkTemplate(gCtx, wid, req.GetTargetSpaceID())
+				recordResult(widStr, wid, data, err)
+				if err != nil && req.GetStrict() {
+					return err
+				}
+				return nil
+			})
+		}
+		_ = eg.Wait()
+	} else {
+		for _, widStr := range req.GetWorkflowIds() {
+			wid, err := strconv.ParseInt(widStr, 10, 64)
+			if err != nil {
+				recordResult(widStr, wid, nil, err)
+				continue
 			}
+
+			data, err := w.copyWkTemplate(ctx, wid, req.GetTargetSpaceID())
+			recordResult(widStr, wid, data, err)
 		}
+	}
 
-		resp.Data[wid] = &workflow.WkPluginBasicData{
-			WorkflowID: wf.ID,
-			SpaceID:    req.GetTargetSpaceID(),
-			Name:       wf.Name,
-			Desc:       wf.Desc,
-			URL:        wf.IconURL,
-			IconURI:    wf.IconURI,
-			Status:     workflow.WorkFlowStatus_HadPublished,
-			PluginID:   wf.ID,
-			CreateTime: time.Now().Unix(),
-			SourceID:   wid,
-			Creator: &workflow.Creator{
-				ID:   strconv.FormatInt(wf.CreatorID, 10),
-				Self: ternary.IFElse[bool](wf.CreatorID == ptr.From(ctxutil.GetUIDFromCtx(ctx)), true, false),
-			},
-			Schema:                wf.Canvas,
-			FlowMode:              wf.Mode,
-			LatestPublishCommitID: wf.CommitID,
-			StartNode:             startNode,
-			EndNode:               endNode,
+	if req.GetStrict() && len(resp.FailedWorkflowIds) > 0 {
+		var firstErr string
+		for _, msg := range resp.FailedWorkflowIds {
+			firstErr = msg
+			break
+		}
+
+		if len(copiedIDs) > 0 {
+			if _, delErr := GetWorkflowDomainSVC().Delete(ctx, &vo.DeletePolicy{IDs: copiedIDs}); delErr != nil {
+				logs.CtxErrorf(ctx, "rollback copied template workflows failed, ids=%v, err=%v", copiedIDs, delErr)
+			}
 		}
 
+		return nil, errors.New(firstErr)
 	}
 
 	return resp, err
 }
 
+func (w *ApplicationService) copyWkTemplate(ctx context.Context, wid int64, targetSpaceID int64) (*workflow.WkPluginBasicData, error) {
+	wf, err := w.copyWorkflow(ctx, wid, vo.CopyWorkflowPolicy{
+		ShouldModifyWorkflowName: false,
+		TargetSpaceID:            ptr.Of(targetSpaceID),
+		TargetAppID:              ptr.Of(int64(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishVersion := "v0.0.0"
+	err = w.publishWorkflowResource(ctx, &vo.PublishPolicy{
+		ID:        wf.ID,
+		Version:   publishVersion,
+		CommitID:  wf.CommitID,
+		CreatorID: ctxutil.MustGetUIDFromCtx(ctx),
+		Force:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validateIssues, err := GetWorkflowDomainSVC().ValidateTree(ctx, wf.ID, vo.ValidateTreeConfig{
+		CanvasSchema: wf.Canvas,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		inputs    []*vo.NamedTypeInfo
+		outputs   []*vo.NamedTypeInfo
+		startNode *workflow.Node
+		endNode   *workflow.Node
+	)
+	if len(wf.InputParamsStr) > 0 {
+		err = sonic.UnmarshalString(wf.InputParamsStr, &inputs)
+		if err != nil {
+			return nil, err
+		}
+		startNode = &workflow.Node{
+			NodeID:    "100001",
+			NodeName:  "start-node",
+			NodeParam: &workflow.NodeParam{InputParameters: make([]*workflow.Parameter, 0, len(inputs))},
+		}
+		for _, in := range inputs {
+			param, err := toWorkflowParameter(in)
+			if err != nil {
+				return nil, err
+			}
+			startNode.NodeParam.InputParameters = append(startNode.NodeParam.InputParameters, param)
+		}
+	}
+
+	if len(wf.OutputParamsStr) > 0 {
+		err = sonic.UnmarshalString(wf.OutputParamsStr, &outputs)
+		if err != nil {
+			return nil, err
+		}
+		endNode = &workflow.Node{
+			NodeID:    entity.ExitNodeKey,
+			NodeName:  "end-node",
+			NodeParam: &workflow.NodeParam{InputParameters: make([]*workflow.Parameter, 0, len(outputs))},
+		}
+		for _, in := range outputs {
+			param, err := toWorkflowParameter(in)
+			if err != nil {
+				return nil, err
+			}
+			endNode.NodeParam.InputParameters = append(endNode.NodeParam.InputParameters, param)
+		}
+	}
+
+	return &workflow.WkPluginBasicData{
+		WorkflowID: wf.ID,
+		SpaceID:    targetSpaceID,
+		Name:       wf.Name,
+		Desc:       wf.Desc,
+		URL:        wf.IconURL,
+		IconURI:    wf.IconURI,
+		Status:     workflow.WorkFlowStatus_HadPublished,
+		PluginID:   wf.ID,
+		CreateTime: time.Now().Unix(),
+		SourceID:   wid,
+		Creator: &workflow.Creator{
+			ID:   strconv.FormatInt(wf.CreatorID, 10),
+			Self: ternary.IFElse[bool](wf.CreatorID == ptr.From(ctxutil.GetUIDFromCtx(ctx)), true, false),
+		},
+		Schema:                wf.Canvas,
+		FlowMode:              wf.Mode,
+		LatestPublishCommitID: wf.CommitID,
+		StartNode:             startNode,
+		EndNode:               endNode,
+		PublishedVersion:      publishVersion,
+		ValidateIssues:        validateIssues,
+	}, nil
+}
+
 func (w *ApplicationService) publishWorkflowResource(ctx context.Context, policy *vo.PublishPolicy) error {
 	err := GetWorkflowDomainSVC().Publish(ctx, policy)
 	if err != nil {
@@ -3558,6 +5764,18 @@ func toWorkflowParameter(nType *vo.NamedTypeInfo) (*workflow.Parameter, error) {
 	if nType.Required {
 		wp.Required = true
 	}
+	if len(nType.Enum) > 0 {
+		wp.EnumOptions = nType.Enum
+	}
+	wp.Min = nType.Min
+	wp.Max = nType.Max
+	if nType.MinLength != nil {
+		wp.MinLength = ptr.Of(int32(*nType.MinLength))
+	}
+	if nType.MaxLength != nil {
+		wp.MaxLength = ptr.Of(int32(*nType.MaxLength))
+	}
+	wp.Pattern = nType.Pattern
 	switch nType.Type {
 	case vo.DataTypeString, vo.DataTypeTime, vo.DataTypeFile:
 		wp.Type = workflow.InputType_String
@@ -3582,9 +5800,24 @@ func toWorkflowParameter(nType *vo.NamedTypeInfo) (*workflow.Parameter, error) {
 			case vo.DataTypeObject:
 				wp.SubType = workflow.InputType_Object
 			}
+			if nType.ElemTypeInfo.Type == vo.DataTypeObject {
+				elemParam, err := toWorkflowParameter(nType.ElemTypeInfo)
+				if err != nil {
+					return nil, err
+				}
+				wp.SubParameters = []*workflow.Parameter{elemParam}
+			}
 		}
 	case vo.DataTypeObject:
 		wp.Type = workflow.InputType_Object
+		wp.SubParameters = make([]*workflow.Parameter, 0, len(nType.Properties))
+		for _, sub := range nType.Properties {
+			subParam, err := toWorkflowParameter(sub)
+			if err != nil {
+				return nil, err
+			}
+			wp.SubParameters = append(wp.SubParameters, subParam)
+		}
 	default:
 		return nil, fmt.Errorf("unknown type: %s", nType.Type)
 
@@ -3615,6 +5848,87 @@ func toVariables(namedTypeInfoList []*vo.NamedTypeInfo) ([]*vo.Variable, error)
 	return vs, nil
 }
 
+type pluginParamCacheKey struct {
+	workflowID int64
+	version    string
+}
+
+var (
+	pluginParamCacheMu sync.Mutex
+	pluginParamCache   = map[pluginParamCacheKey][]*common.PluginParameter{}
+)
+
+// getPluginParameters converts a workflow's InputParams to plugin parameters for
+// GetPlaygroundPluginList, caching the result by workflow ID and version so a space with many
+// published workflows doesn't re-run the conversion on every playground list request. The cache
+// is invalidated per-workflow by invalidatePluginParamCache on publish.
+func getPluginParameters(workflowID int64, version string, inputParams []*vo.NamedTypeInfo) ([]*common.PluginParameter, error) {
+	key := pluginParamCacheKey{workflowID: workflowID, version: version}
+
+	pluginParamCacheMu.Lock()
+	cached, ok := pluginParamCache[key]
+	pluginParamCacheMu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	params, err := slices.TransformWithErrorCheck(inputParams, toPluginParameter)
+	if err != nil {
+		return nil, err
+	}
+
+	pluginParamCacheMu.Lock()
+	pluginParamCache[key] = params
+	pluginParamCacheMu.Unlock()
+
+	return params, nil
+}
+
+// invalidatePluginParamCache drops any cached playground plugin parameters for workflowID, so a
+// republish under the same version string doesn't keep serving stale parameters.
+func invalidatePluginParamCache(workflowID int64) {
+	pluginParamCacheMu.Lock()
+	defer pluginParamCacheMu.Unlock()
+
+	for key := range pluginParamCache {
+		if key.workflowID == workflowID {
+			delete(pluginParamCache, key)
+		}
+	}
+}
+
+// inputParamsQualityWarning flags workflows whose InputParams are missing descriptions, since an
+// LLM calls a workflow-plugin poorly when it can't tell what a parameter is for. Returns nil when
+// every input parameter has a description.
+func inputParamsQualityWarning(inputParams []*vo.NamedTypeInfo) *string {
+	missing := 0
+	for _, p := range inputParams {
+		if p.Desc == "" {
+			missing++
+		}
+	}
+
+	if missing == 0 {
+		return nil
+	}
+
+	return ptr.Of(fmt.Sprintf("%d parameter(s) missing descriptions", missing))
+}
+
+// deprecationWarningFor returns a warning describing wf's deprecation, or nil if the resolved
+// version isn't deprecated, so OpenAPIRun/OpenAPIStreamRun callers know to migrate off it.
+func deprecationWarningFor(wf *entity.Workflow) *string {
+	if wf.VersionMeta == nil || !wf.VersionMeta.Deprecated {
+		return nil
+	}
+
+	if wf.VersionMeta.DeprecationMessage != "" {
+		return ptr.Of(fmt.Sprintf("version %s is deprecated: %s", wf.VersionMeta.Version, wf.VersionMeta.DeprecationMessage))
+	}
+
+	return ptr.Of(fmt.Sprintf("version %s is deprecated", wf.VersionMeta.Version))
+}
+
 func toPluginParameter(info *vo.NamedTypeInfo) (*common.PluginParameter, error) {
 	if info == nil {
 		return nil, fmt.Errorf("named type info is nil")
@@ -3834,22 +6148,54 @@ func toVariable(p *workflow.APIParameter) (*vo.Variable, error) {
 	return v, nil
 }
 
-func mergeWorkflowAPIParameters(latestAPIParameters []*workflow.APIParameter, existAPIParameters []*workflow.APIParameter) {
+// mergeWorkflowAPIParameters carries the user's local overrides (LocalDisable/LocalDefault) from
+// existAPIParameters onto the latest schema, matching by Name, and returns the merged slice.
+// Parameters only present in the latest schema are kept as-is; parameters only present in
+// existAPIParameters (removed from the schema since) are dropped.
+func mergeWorkflowAPIParameters(latestAPIParameters []*workflow.APIParameter, existAPIParameters []*workflow.APIParameter) []*workflow.APIParameter {
 	existAPIParameterMap := slices.ToMap(existAPIParameters, func(w *workflow.APIParameter) (string, *workflow.APIParameter) {
 		return w.Name, w
 	})
 
+	merged := make([]*workflow.APIParameter, 0, len(latestAPIParameters))
 	for _, parameter := range latestAPIParameters {
-		if ep, ok := existAPIParameterMap[parameter.Name]; ok {
-			parameter.LocalDisable = ep.LocalDisable
-			parameter.LocalDefault = ep.LocalDefault
-			if len(parameter.SubParameters) > 0 && len(ep.SubParameters) > 0 {
-				mergeWorkflowAPIParameters(parameter.SubParameters, ep.SubParameters)
-			}
+		ep, ok := existAPIParameterMap[parameter.Name]
+		if !ok {
+			merged = append(merged, parameter)
+			continue
+		}
 
-		} else {
-			existAPIParameters = append(existAPIParameters, parameter)
+		parameter.LocalDisable = ep.LocalDisable
+		parameter.LocalDefault = ep.LocalDefault
+
+		if parameter.Type == workflow.ParameterType_Array {
+			// Array SubParameters hold a single, unnamed entry describing the item schema, so it
+			// can't be matched by Name like object properties - merge it positionally instead,
+			// otherwise local overrides on array-of-object fields are silently dropped.
+			mergeWorkflowAPIParameterElement(parameter, ep)
+		} else if len(parameter.SubParameters) > 0 && len(ep.SubParameters) > 0 {
+			parameter.SubParameters = mergeWorkflowAPIParameters(parameter.SubParameters, ep.SubParameters)
 		}
+
+		merged = append(merged, parameter)
+	}
+
+	return merged
+}
+
+func mergeWorkflowAPIParameterElement(latest, exist *workflow.APIParameter) {
+	if len(latest.SubParameters) != 1 || len(exist.SubParameters) != 1 {
+		return
+	}
+
+	latestElem, existElem := latest.SubParameters[0], exist.SubParameters[0]
+	latestElem.LocalDisable = existElem.LocalDisable
+	latestElem.LocalDefault = existElem.LocalDefault
+
+	if latestElem.Type == workflow.ParameterType_Array {
+		mergeWorkflowAPIParameterElement(latestElem, existElem)
+	} else if len(latestElem.SubParameters) > 0 && len(existElem.SubParameters) > 0 {
+		latestElem.SubParameters = mergeWorkflowAPIParameters(latestElem.SubParameters, existElem.SubParameters)
 	}
 }
 
@@ -3864,6 +6210,11 @@ func parseWorkflowTerminatePlanType(c *vo.Canvas) (int32, error) {
 	if endNode == nil {
 		return 0, fmt.Errorf("can not find end node")
 	}
+	if endNode.Data == nil || endNode.Data.Inputs == nil || endNode.Data.Inputs.TerminatePlan == nil {
+		// Malformed or not-yet-configured end node; treat it like ReturnVariables rather than
+		// panicking on the nil dereference below.
+		return 0, nil
+	}
 	switch *endNode.Data.Inputs.TerminatePlan {
 	case vo.ReturnVariables:
 		return 0, nil
@@ -3894,10 +6245,26 @@ func (g *GetLLMNodeFCSettingDetailResponse) MarshalJSON() ([]byte, error) {
 	return sonic.Marshal(result)
 }
 
+type userSpaceListCacheKey struct {
+	uid int64
+}
+
 func checkUserSpace(ctx context.Context, uid int64, spaceID int64) error {
-	spaces, err := crossuser.DefaultSVC().GetUserSpaceList(ctx, uid)
-	if err != nil {
-		return err
+	if ctxutil.HasAdminCapability(ctx) {
+		logs.CtxInfof(ctx, "[checkUserSpace] admin bypass granted, uid=%d, spaceID=%d", uid, spaceID)
+		return nil
+	}
+
+	cacheKey := userSpaceListCacheKey{uid: uid}
+
+	spaces, ok := ctxcache.Get[[]*crossuser.EntitySpace](ctx, cacheKey)
+	if !ok {
+		var err error
+		spaces, err = crossuser.DefaultSVC().GetUserSpaceList(ctx, uid)
+		if err != nil {
+			return err
+		}
+		ctxcache.Store(ctx, cacheKey, spaces)
 	}
 
 	var match bool
@@ -3909,12 +6276,104 @@ func checkUserSpace(ctx context.Context, uid int64, spaceID int64) error {
 	}
 
 	if !match {
-		return fmt.Errorf("user %d does not have access to space %d", uid, spaceID)
+		return vo.WrapError(errno.ErrWorkflowPermissionDenied,
+			fmt.Errorf("user %d does not have access to space %d", uid, spaceID),
+			errorx.KV("uid", strconv.FormatInt(uid, 10)), errorx.KV("space_id", strconv.FormatInt(spaceID, 10)))
 	}
 
 	return nil
 }
 
+// checkEditLock rejects the write if another user currently holds the workflow's explicit
+// edit lock. Workflows with no lock held, which is the common case since acquiring one is
+// opt-in, are unaffected.
+func checkEditLock(ctx context.Context, workflowID, uID int64) error {
+	lock, held, err := GetWorkflowDomainSVC().GetEditLock(ctx, workflowID)
+	if err != nil {
+		return err
+	}
+	if !held || lock.HolderID == uID {
+		return nil
+	}
+
+	return vo.WrapError(errno.ErrWorkflowEditLockHeld,
+		fmt.Errorf("workflow %d is locked for editing by user %d", workflowID, lock.HolderID),
+		errorx.KV("id", strconv.FormatInt(workflowID, 10)), errorx.KV("holder_id", strconv.FormatInt(lock.HolderID, 10)))
+}
+
+// AcquireWorkflowEditLock acquires the explicit draft-edit lock for workflowID on behalf of the
+// current user, failing if another user already holds it. The lock expires automatically after
+// repo.WorkflowEditLockTTL unless released or re-acquired.
+func (w *ApplicationService) AcquireWorkflowEditLock(ctx context.Context, req *workflow.AcquireWorkflowEditLockRequest) (
+	_ *workflow.AcquireWorkflowEditLockResponse, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	workflowID := mustParseInt64(req.GetWorkflowID())
+	spaceID := mustParseInt64(req.GetSpaceID())
+
+	uID := ctxutil.MustGetUIDFromCtx(ctx)
+	if err := checkUserSpace(ctx, uID, spaceID); err != nil {
+		return nil, err
+	}
+
+	ok, err := GetWorkflowDomainSVC().AcquireEditLock(ctx, workflowID, uID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		lock, held, lErr := GetWorkflowDomainSVC().GetEditLock(ctx, workflowID)
+		if lErr != nil {
+			return nil, lErr
+		}
+		if held {
+			return nil, vo.WrapError(errno.ErrWorkflowEditLockHeld,
+				fmt.Errorf("workflow %d is locked for editing by user %d", workflowID, lock.HolderID),
+				errorx.KV("id", strconv.FormatInt(workflowID, 10)), errorx.KV("holder_id", strconv.FormatInt(lock.HolderID, 10)))
+		}
+	}
+
+	return &workflow.AcquireWorkflowEditLockResponse{}, nil
+}
+
+// ReleaseWorkflowEditLock releases the current user's edit lock on workflowID, if held. Releasing
+// a lock held by another user or a workflow with no lock held is a no-op.
+func (w *ApplicationService) ReleaseWorkflowEditLock(ctx context.Context, req *workflow.ReleaseWorkflowEditLockRequest) (
+	_ *workflow.ReleaseWorkflowEditLockResponse, err error,
+) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			err = safego.NewPanicErr(panicErr, debug.Stack())
+		}
+
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrWorkflowOperationFail, err, errorx.KV("cause", vo.UnwrapRootErr(err).Error()))
+		}
+	}()
+
+	workflowID := mustParseInt64(req.GetWorkflowID())
+	spaceID := mustParseInt64(req.GetSpaceID())
+
+	uID := ctxutil.MustGetUIDFromCtx(ctx)
+	if err := checkUserSpace(ctx, uID, spaceID); err != nil {
+		return nil, err
+	}
+
+	if err := GetWorkflowDomainSVC().ReleaseEditLock(ctx, workflowID, uID); err != nil {
+		return nil, err
+	}
+
+	return &workflow.ReleaseWorkflowEditLockResponse{}, nil
+}
+
 func (w *ApplicationService) populateChatFlowRoleFields(role *workflow.ChatFlowRole, targetRole interface{}) error {
 	var avatarUri, audioStr, bgStr, obStr, srStr, uiStr string
 	var err error
@@ -4012,7 +6471,7 @@ func IsChatFlow(wf *entity.Workflow) bool {
 	if wf == nil || wf.ID == 0 {
 		return false
 	}
-	return wf.Meta.Mode == workflow.WorkflowMode_ChatFlow
+	return wf.IsChatFlow()
 }
 
 func (w *ApplicationService) CreateChatFlowRole(ctx context.Context, req *workflow.CreateChatFlowRoleRequest) (
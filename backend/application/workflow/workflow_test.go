@@ -25,8 +25,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/coze-dev/coze-studio/backend/api/model/workflow"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
 	"github.com/coze-dev/coze-studio/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-studio/backend/pkg/lang/slices"
+	"github.com/coze-dev/coze-studio/backend/pkg/sonic"
 )
 
 func TestToVariable(t *testing.T) {
@@ -157,3 +160,208 @@ func TestToVariable(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeWorkflowAPIParameters_ArrayOfObject(t *testing.T) {
+	latest := []*workflow.APIParameter{
+		{
+			Name: "items",
+			Type: workflow.ParameterType_Array,
+			SubParameters: []*workflow.APIParameter{
+				{
+					Type: workflow.ParameterType_Object,
+					SubParameters: []*workflow.APIParameter{
+						{Name: "id", Type: workflow.ParameterType_String},
+					},
+				},
+			},
+		},
+	}
+	exist := []*workflow.APIParameter{
+		{
+			Name:         "items",
+			Type:         workflow.ParameterType_Array,
+			LocalDisable: true,
+			LocalDefault: ptr.Of("[]"),
+			SubParameters: []*workflow.APIParameter{
+				{
+					Type:         workflow.ParameterType_Object,
+					LocalDisable: true,
+					SubParameters: []*workflow.APIParameter{
+						{Name: "id", Type: workflow.ParameterType_String, LocalDisable: true, LocalDefault: ptr.Of("unset")},
+					},
+				},
+			},
+		},
+	}
+
+	merged := mergeWorkflowAPIParameters(latest, exist)
+
+	require.Len(t, merged, 1)
+	assert.True(t, merged[0].LocalDisable)
+	assert.Equal(t, "[]", *merged[0].LocalDefault)
+
+	elem := merged[0].SubParameters[0]
+	assert.True(t, elem.LocalDisable)
+
+	id := elem.SubParameters[0]
+	assert.True(t, id.LocalDisable)
+	assert.Equal(t, "unset", *id.LocalDefault)
+}
+
+func TestMergeWorkflowAPIParameters_NewParameterPersisted(t *testing.T) {
+	latest := []*workflow.APIParameter{
+		{Name: "existing", Type: workflow.ParameterType_String},
+		{Name: "brand_new", Type: workflow.ParameterType_String},
+	}
+	exist := []*workflow.APIParameter{
+		{Name: "existing", Type: workflow.ParameterType_String, LocalDisable: true},
+	}
+
+	merged := mergeWorkflowAPIParameters(latest, exist)
+
+	require.Len(t, merged, 2)
+	names := make([]string, 0, len(merged))
+	for _, p := range merged {
+		names = append(names, p.Name)
+	}
+	assert.Contains(t, names, "brand_new")
+	assert.Contains(t, names, "existing")
+}
+
+func TestEffectiveAPIParameters(t *testing.T) {
+	params := []*workflow.APIParameter{
+		{Name: "disabled_globally", Type: workflow.ParameterType_String, GlobalDisable: true},
+		{Name: "disabled_locally", Type: workflow.ParameterType_String, LocalDisable: true},
+		{Name: "local_default", Type: workflow.ParameterType_String, LocalDefault: ptr.Of("local")},
+		{Name: "global_default", Type: workflow.ParameterType_String, GlobalDefault: ptr.Of("global")},
+		{
+			Name: "nested",
+			Type: workflow.ParameterType_Object,
+			SubParameters: []*workflow.APIParameter{
+				{Name: "inner_disabled", Type: workflow.ParameterType_String, LocalDisable: true},
+				{Name: "inner_kept", Type: workflow.ParameterType_String},
+			},
+		},
+	}
+
+	effective := effectiveAPIParameters(params)
+
+	names := make([]string, 0, len(effective))
+	for _, p := range effective {
+		names = append(names, p.Name)
+	}
+	assert.NotContains(t, names, "disabled_globally")
+	assert.NotContains(t, names, "disabled_locally")
+	assert.Contains(t, names, "local_default")
+	assert.Contains(t, names, "global_default")
+	assert.Contains(t, names, "nested")
+
+	byName := slices.ToMap(effective, func(p *workflow.APIParameter) (string, *workflow.APIParameter) {
+		return p.Name, p
+	})
+	require.NotNil(t, byName["local_default"].Value)
+	assert.Equal(t, "local", *byName["local_default"].Value)
+	require.NotNil(t, byName["global_default"].Value)
+	assert.Equal(t, "global", *byName["global_default"].Value)
+
+	nested := byName["nested"]
+	require.Len(t, nested.SubParameters, 1)
+	assert.Equal(t, "inner_kept", nested.SubParameters[0].Name)
+}
+
+func TestToWorkflowParameter_NestedObject(t *testing.T) {
+	nType := &vo.NamedTypeInfo{
+		Name:     "user",
+		Type:     vo.DataTypeObject,
+		Required: true,
+		Properties: []*vo.NamedTypeInfo{
+			{Name: "id", Type: vo.DataTypeInteger},
+			{
+				Name: "address",
+				Type: vo.DataTypeObject,
+				Properties: []*vo.NamedTypeInfo{
+					{Name: "city", Type: vo.DataTypeString},
+					{
+						Name: "tags",
+						Type: vo.DataTypeArray,
+						ElemTypeInfo: &vo.NamedTypeInfo{
+							Type: vo.DataTypeObject,
+							Properties: []*vo.NamedTypeInfo{
+								{Name: "key", Type: vo.DataTypeString},
+								{Name: "value", Type: vo.DataTypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	param, err := toWorkflowParameter(nType)
+	require.NoError(t, err)
+
+	assert.Equal(t, "user", param.Name)
+	assert.Equal(t, workflow.InputType_Object, param.Type)
+	require.Len(t, param.SubParameters, 2)
+
+	byName := slices.ToMap(param.SubParameters, func(p *workflow.Parameter) (string, *workflow.Parameter) {
+		return p.Name, p
+	})
+
+	id := byName["id"]
+	require.NotNil(t, id)
+	assert.Equal(t, workflow.InputType_Integer, id.Type)
+	assert.Empty(t, id.SubParameters)
+
+	address := byName["address"]
+	require.NotNil(t, address)
+	assert.Equal(t, workflow.InputType_Object, address.Type)
+	require.Len(t, address.SubParameters, 2)
+
+	addressByName := slices.ToMap(address.SubParameters, func(p *workflow.Parameter) (string, *workflow.Parameter) {
+		return p.Name, p
+	})
+
+	tags := addressByName["tags"]
+	require.NotNil(t, tags)
+	assert.Equal(t, workflow.InputType_Array, tags.Type)
+	assert.Equal(t, workflow.InputType_Object, tags.SubType)
+	require.Len(t, tags.SubParameters, 1)
+
+	tagElem := tags.SubParameters[0]
+	require.Len(t, tagElem.SubParameters, 2)
+	tagElemByName := slices.ToMap(tagElem.SubParameters, func(p *workflow.Parameter) (string, *workflow.Parameter) {
+		return p.Name, p
+	})
+	assert.Contains(t, tagElemByName, "key")
+	assert.Contains(t, tagElemByName, "value")
+}
+
+func TestConvertNodeExecution_SparseIndexedExecutions(t *testing.T) {
+	nodeExe := &entity.NodeExecution{
+		NodeID:   "batch_1",
+		NodeType: entity.NodeTypeBatch,
+		Status:   entity.NodeSuccess,
+		IndexedExecutions: []*entity.NodeExecution{
+			{NodeID: "batch_1_inner", NodeType: entity.NodeTypeBatch, Status: entity.NodeSuccess},
+			nil,
+			{NodeID: "batch_1_inner", NodeType: entity.NodeTypeBatch, Status: entity.NodeFailed},
+		},
+	}
+
+	nr, err := convertNodeExecution(nodeExe)
+	require.NoError(t, err)
+	require.NotNil(t, nr.Batch)
+
+	var subResults []*workflow.NodeResult
+	require.NoError(t, sonic.UnmarshalString(*nr.Batch, &subResults))
+
+	require.Len(t, subResults, 3)
+	assert.NotNil(t, subResults[0])
+	assert.Nil(t, subResults[1])
+	assert.NotNil(t, subResults[2])
+
+	assert.Equal(t, int32(1), nr.GetBatchSuccessCount())
+	assert.Equal(t, int32(1), nr.GetBatchFailCount())
+	assert.Equal(t, int32(3), nr.GetBatchTotal())
+}
@@ -23,16 +23,27 @@ import (
 	"strconv"
 
 	"github.com/coze-dev/coze-studio/backend/bizpkg/config"
+	"github.com/coze-dev/coze-studio/backend/pkg/envkey"
 	"github.com/coze-dev/coze-studio/backend/pkg/logs"
 )
 
+// workflowDebugURLBase, when set, overrides the server host used to build workflow debug URLs
+// instead of the admin-configurable ServerHost setting. It's resolved once at process start,
+// since self-hosted deployments behind a custom domain set it via environment and don't expect it
+// to change at runtime.
+var workflowDebugURLBase = envkey.GetStringD("WORKFLOW_DEBUG_URL_BASE", "")
+
 func GetWorkflowDebugURL(ctx context.Context, workflowID, spaceID, executeID int64) string {
 	defaultURL := fmt.Sprintf("http://127.0.0.1:8888/work_flow?execute_id=%d&space_id=%d&workflow_id=%d&execute_mode=2", executeID, spaceID, workflowID)
 
-	serverHost, err := config.Base().GetServerHost(ctx)
-	if err != nil {
-		logs.CtxErrorf(ctx, "[GetWorkflowDebugURL] get base config failed, use default debug url instead, err: %v", err)
-		return defaultURL
+	serverHost := workflowDebugURLBase
+	if serverHost == "" {
+		var err error
+		serverHost, err = config.Base().GetServerHost(ctx)
+		if err != nil {
+			logs.CtxErrorf(ctx, "[GetWorkflowDebugURL] get base config failed, use default debug url instead, err: %v", err)
+			return defaultURL
+		}
 	}
 
 	workFlowURL, err := url.JoinPath(serverHost, "work_flow")
@@ -166,19 +166,36 @@ func (i *impl) ListKnowledgeDetail(ctx context.Context, req *model.ListKnowledge
 		return nil, err
 	}
 
-	resp := &model.ListKnowledgeDetailResponse{
-		KnowledgeDetails: slices.Transform(response.Knowledge, func(a *model.Knowledge) *model.KnowledgeDetail {
-			return &model.KnowledgeDetail{
-				ID:          a.ID,
-				Name:        a.Name,
-				Description: a.Description,
-				IconURL:     a.IconURL,
-				FormatType:  int64(a.Type),
+	details := make([]*model.KnowledgeDetail, 0, len(response.Knowledge))
+	for _, a := range response.Knowledge {
+		detail := &model.KnowledgeDetail{
+			ID:          a.ID,
+			Name:        a.Name,
+			Description: a.Description,
+			IconURL:     a.IconURL,
+			FormatType:  int64(a.Type),
+		}
+
+		documentResp, err := i.DomainSVC.ListDocument(ctx, &service.ListDocumentRequest{
+			KnowledgeID: a.ID,
+			SelectAll:   true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		detail.DocumentCount = int64(len(documentResp.Documents))
+		for _, doc := range documentResp.Documents {
+			detail.TotalSize += doc.Size
+			if doc.Status == entity.DocumentStatusUploading || doc.Status == entity.DocumentStatusChunking {
+				detail.IsIndexing = true
 			}
-		}),
+		}
+
+		details = append(details, detail)
 	}
 
-	return resp, nil
+	return &model.ListKnowledgeDetailResponse{KnowledgeDetails: details}, nil
 }
 
 func (i *impl) MGetSlice(ctx context.Context, request *model.MGetSliceRequest) (response *model.MGetSliceResponse, err error) {
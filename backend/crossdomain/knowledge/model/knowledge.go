@@ -333,11 +333,15 @@ type DeleteDocumentResponse struct {
 }
 
 type KnowledgeDetail struct {
-	ID          int64  `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	IconURL     string `json:"-"`
-	FormatType  int64  `json:"-"`
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	IconURL       string `json:"-"`
+	FormatType    int64  `json:"-"`
+	DocumentCount int64  `json:"-"`
+	TotalSize     int64  `json:"-"`
+	// IsIndexing is true while any document in the knowledge base is still uploading/chunking
+	IsIndexing bool `json:"-"`
 }
 
 type ListKnowledgeDetailRequest struct {
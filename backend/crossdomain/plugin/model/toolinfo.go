@@ -51,6 +51,11 @@ type ToolInfo struct {
 	SubURL    *string
 	Operation *Openapi3Operation
 
+	// RateLimitPerSecond/RateLimitPerMinute cap how often this tool may be called, as configured
+	// on the plugin product backing it. nil means no limit is configured for that window.
+	RateLimitPerSecond *int
+	RateLimitPerMinute *int
+
 	AgentID *int64
 }
 
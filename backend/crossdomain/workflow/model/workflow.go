@@ -57,6 +57,10 @@ type ExecuteConfig struct {
 	SectionID                         *int64
 	MaxHistoryRounds                  *int32
 	InputFileFields                   map[string]*FileInfo
+	Breakpoints                       []string // node IDs to pause at during a debug TestRun, for step-through debugging
+	// ExperimentVariant tags this execution with a caller-supplied experiment/variant label
+	// (e.g. "A" or "B"), so runs can later be grouped for A/B comparison.
+	ExperimentVariant string
 }
 
 type ExecuteMode string
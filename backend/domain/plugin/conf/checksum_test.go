@@ -0,0 +1,104 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conf
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSha256File(t *testing.T) {
+	dir := t.TempDir()
+	filePath := path.Join(dir, "doc.yaml")
+	require.NoError(t, os.WriteFile(filePath, []byte("openapi: 3.0.0"), 0o644))
+
+	sum, err := sha256File(filePath)
+	require.NoError(t, err)
+
+	want := sha256.Sum256([]byte("openapi: 3.0.0"))
+	assert.Equal(t, hex.EncodeToString(want[:]), sum)
+}
+
+func TestSha256File_MissingFile(t *testing.T) {
+	_, err := sha256File(path.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+// writePluginMetaFixture lays out basePath/pluginproduct/plugin_meta.yaml plus its referenced
+// openapi doc file, pinning openapiDocSHA256 as the plugin's checksum (empty to skip pinning).
+func writePluginMetaFixture(t *testing.T, basePath, docContent, openapiDocSHA256 string) {
+	t.Helper()
+
+	root := path.Join(basePath, pluginProductDirName())
+	require.NoError(t, os.MkdirAll(root, 0o755))
+	require.NoError(t, os.WriteFile(path.Join(root, "doc.yaml"), []byte(docContent), 0o644))
+
+	metaYAML := `
+- plugin_id: 1
+  version: v1.0.0
+  plugin_type: 1
+  openapi_doc_file: doc.yaml
+  openapi_doc_sha256: "` + openapiDocSHA256 + `"
+  manifest:
+    schema_version: v1
+    name_for_model: test_plugin
+    name_for_human: Test Plugin
+    description_for_model: a test plugin
+    description_for_human: a test plugin
+    auth:
+      type: none
+    api:
+      type: openapi
+  tools: []
+`
+	require.NoError(t, os.WriteFile(path.Join(root, "plugin_meta.yaml"), []byte(metaYAML), 0o644))
+}
+
+func TestValidatePluginMeta_ChecksumMismatchSkipsPlugin(t *testing.T) {
+	basePath := t.TempDir()
+	writePluginMetaFixture(t, basePath, "openapi: 3.0.0", "0000000000000000000000000000000000000000000000000000000000000000")
+
+	report, err := ValidatePluginMeta(context.Background(), basePath)
+	require.NoError(t, err)
+	require.Len(t, report.Plugins, 1)
+
+	errs := report.Plugins[0].Errors
+	require.NotEmpty(t, errs)
+	assert.Contains(t, errs[0], "sha256 mismatch")
+}
+
+func TestValidatePluginMeta_ChecksumMatchProceedsPastTheChecksumCheck(t *testing.T) {
+	basePath := t.TempDir()
+	docContent := "openapi: 3.0.0"
+	sum := sha256.Sum256([]byte(docContent))
+	writePluginMetaFixture(t, basePath, docContent, hex.EncodeToString(sum[:]))
+
+	report, err := ValidatePluginMeta(context.Background(), basePath)
+	require.NoError(t, err)
+	require.Len(t, report.Plugins, 1)
+
+	for _, e := range report.Plugins[0].Errors {
+		assert.NotContains(t, e, "sha256 mismatch")
+	}
+}
@@ -18,10 +18,14 @@ package conf
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
 	"path"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -34,6 +38,7 @@ import (
 	"github.com/coze-dev/coze-studio/backend/crossdomain/plugin/model"
 	"github.com/coze-dev/coze-studio/backend/domain/plugin/dto"
 	"github.com/coze-dev/coze-studio/backend/domain/plugin/entity"
+	"github.com/coze-dev/coze-studio/backend/pkg/envkey"
 	"github.com/coze-dev/coze-studio/backend/pkg/lang/ptr"
 	"github.com/coze-dev/coze-studio/backend/pkg/logs"
 )
@@ -46,6 +51,9 @@ type pluginProductMeta struct {
 	OpenapiDocFile string                `yaml:"openapi_doc_file" validate:"required"`
 	Manifest       *model.PluginManifest `yaml:"manifest" validate:"required"`
 	Tools          []*toolProductMeta    `yaml:"tools" validate:"required"`
+	// OpenapiDocSHA256, if set, must match the sha256 of OpenapiDocFile's contents; a mismatch
+	// skips the whole plugin, to detect a tampered or corrupted bundled openapi doc.
+	OpenapiDocSHA256 string `yaml:"openapi_doc_sha256"`
 }
 
 type toolProductMeta struct {
@@ -53,6 +61,15 @@ type toolProductMeta struct {
 	Deprecated bool   `yaml:"deprecated"`
 	Method     string `yaml:"method" validate:"required"`
 	SubURL     string `yaml:"sub_url" validate:"required"`
+	// Version overrides the plugin's version for this tool; falls back to the plugin version when unset.
+	Version string `yaml:"version"`
+	// RateLimitPerSecond/RateLimitPerMinute cap how often the execution layer may call this
+	// tool. 0 (the default) means no limit is enforced for that window.
+	RateLimitPerSecond int `yaml:"rate_limit_per_second"`
+	RateLimitPerMinute int `yaml:"rate_limit_per_minute"`
+	// OpenapiDocSHA256, if set, must match the sha256 of the plugin's OpenapiDocFile contents; a
+	// mismatch skips just this tool, for tools that want their own pinned integrity check.
+	OpenapiDocSHA256 string `yaml:"openapi_doc_sha256"`
 }
 
 var (
@@ -60,6 +77,62 @@ var (
 	toolProducts   map[int64]*ToolInfo
 )
 
+// envPluginProductDir overrides the "pluginproduct" subdirectory name joined onto basePath by
+// loadPluginProductMeta/ValidatePluginMeta, for deployments that lay out plugin conf differently.
+const envPluginProductDir = "PLUGIN_PRODUCT_DIR"
+
+// defaultPluginProductDir is the subdirectory name used when envPluginProductDir is unset.
+const defaultPluginProductDir = "pluginproduct"
+
+func pluginProductDirName() string {
+	return envkey.GetStringD(envPluginProductDir, defaultPluginProductDir)
+}
+
+// pluginMetaFilePattern matches every plugin meta file loaded/merged by resolvePluginMetaFiles:
+// the original single "plugin_meta.yaml" plus any "plugin_meta*.yaml" split out alongside it.
+const pluginMetaFilePattern = "plugin_meta*.yaml"
+
+// resolvePluginMetaFiles validates that root exists and is a directory, then returns every
+// plugin_meta*.yaml file inside it in a stable (sorted) order, for loadPluginProductMeta and
+// ValidatePluginMeta to parse and merge. Returns an actionable error identifying which path is
+// misconfigured instead of letting a bare os.ReadFile/glob error surface first.
+func resolvePluginMetaFiles(root string) ([]string, error) {
+	rootInfo, err := os.Stat(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("plugin product directory '%s' does not exist; check the plugin conf base path and %s", root, envPluginProductDir)
+		}
+		return nil, fmt.Errorf("plugin product directory '%s' is not accessible: %v", root, err)
+	}
+	if !rootInfo.IsDir() {
+		return nil, fmt.Errorf("plugin product path '%s' is not a directory", root)
+	}
+
+	metaFiles, err := filepath.Glob(path.Join(root, pluginMetaFilePattern))
+	if err != nil {
+		return nil, fmt.Errorf("glob plugin meta files in '%s' failed: %v", root, err)
+	}
+	if len(metaFiles) == 0 {
+		return nil, fmt.Errorf("no '%s' files found in '%s'", pluginMetaFilePattern, root)
+	}
+
+	sort.Strings(metaFiles)
+
+	return metaFiles, nil
+}
+
+// sha256File returns the hex-encoded sha256 of filePath's contents, for verifying an openapi doc
+// against a checksum pinned in plugin_meta.yaml.
+func sha256File(filePath string) (string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("read file '%s' for checksum verification failed: %v", filePath, err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 func GetToolProduct(toolID int64) (*ToolInfo, bool) {
 	ti, ok := toolProducts[toolID]
 	if !ok {
@@ -85,15 +158,42 @@ func MGetToolProducts(toolIDs []int64) []*ToolInfo {
 	return tools
 }
 
+// GetToolAPIDetail returns the HTTP method/subURL of a tool product together with its
+// plugin's server URL, as resolved from the loaded toolProducts/pluginProducts registry.
+func GetToolAPIDetail(toolID int64) (api dto.UniqueToolAPI, serverURL string, ok bool) {
+	ti, ok := toolProducts[toolID]
+	if !ok {
+		return dto.UniqueToolAPI{}, "", false
+	}
+
+	pl, ok := pluginProducts[ti.Info.PluginID]
+	if !ok {
+		return dto.UniqueToolAPI{}, "", false
+	}
+
+	api = dto.UniqueToolAPI{
+		Method: ptr.FromOrDefault(ti.Info.Method, ""),
+		SubURL: ptr.FromOrDefault(ti.Info.SubURL, ""),
+	}
+
+	return api, ptr.FromOrDefault(pl.Info.ServerURL, ""), true
+}
+
 func GetPluginProduct(pluginID int64) (*PluginInfo, bool) {
 	pl, ok := pluginProducts[pluginID]
-	return pl, ok
+	if !ok {
+		return nil, false
+	}
+
+	pl_ := deepcopy.Copy(pl).(*PluginInfo)
+
+	return pl_, true
 }
 
 func MGetPluginProducts(pluginIDs []int64) []*PluginInfo {
 	plugins := make([]*PluginInfo, 0, len(pluginIDs))
 	for _, pluginID := range pluginIDs {
-		pl, ok := pluginProducts[pluginID]
+		pl, ok := GetPluginProduct(pluginID)
 		if !ok {
 			continue
 		}
@@ -104,7 +204,11 @@ func MGetPluginProducts(pluginIDs []int64) []*PluginInfo {
 
 func GetAllPluginProducts() []*PluginInfo {
 	plugins := make([]*PluginInfo, 0, len(pluginProducts))
-	for _, pl := range pluginProducts {
+	for pluginID := range pluginProducts {
+		pl, ok := GetPluginProduct(pluginID)
+		if !ok {
+			continue
+		}
 		plugins = append(plugins, pl)
 	}
 	return plugins
@@ -260,25 +364,33 @@ type ToolInfo struct {
 
 func loadPluginProductMeta(ctx context.Context, basePath string) (err error) {
 	log.Printf("[步骤 1] 开始加载插件，基础路径: %s", basePath)
-	root := path.Join(basePath, "pluginproduct")
-	metaFile := path.Join(root, "plugin_meta.yaml")
+	root := path.Join(basePath, pluginProductDirName())
 
-	log.Printf("[步骤 2] 准备读取主元数据文件: %s", metaFile)
-	file, err := os.ReadFile(metaFile)
+	metaFiles, err := resolvePluginMetaFiles(root)
 	if err != nil {
-		log.Printf("[错误] 读取主元数据文件 '%s' 失败: %v", metaFile, err)
-		return fmt.Errorf("read file '%s' failed, err=%v", metaFile, err)
+		log.Printf("[错误] %v", err)
+		return err
 	}
-	log.Printf("[成功] 已成功读取主元数据文件: %s", metaFile)
+	log.Printf("[步骤 2] 发现 %d 个插件元数据文件: %v", len(metaFiles), metaFiles)
 
 	var pluginsMeta []*pluginProductMeta
-	log.Println("[步骤 3] 准备解析 YAML 文件内容...")
-	err = yaml.Unmarshal(file, &pluginsMeta)
-	if err != nil {
-		log.Printf("[错误] 解析 YAML 文件 '%s' 失败: %v", metaFile, err)
-		return fmt.Errorf("unmarshal file '%s' failed, err=%v", metaFile, err)
+	for _, metaFile := range metaFiles {
+		file, err := os.ReadFile(metaFile)
+		if err != nil {
+			log.Printf("[错误] 读取插件元数据文件 '%s' 失败: %v", metaFile, err)
+			return fmt.Errorf("read file '%s' failed, err=%v", metaFile, err)
+		}
+
+		var fileMeta []*pluginProductMeta
+		if err = yaml.Unmarshal(file, &fileMeta); err != nil {
+			log.Printf("[错误] 解析 YAML 文件 '%s' 失败: %v", metaFile, err)
+			return fmt.Errorf("unmarshal file '%s' failed, err=%v", metaFile, err)
+		}
+		log.Printf("[成功] 已从 '%s' 加载 %d 个插件的元数据定义。", metaFile, len(fileMeta))
+
+		pluginsMeta = append(pluginsMeta, fileMeta...)
 	}
-	log.Printf("[成功] YAML 解析完成，共找到 %d 个插件的元数据定义。", len(pluginsMeta))
+	log.Printf("[成功] 所有元数据文件解析完成，共找到 %d 个插件的元数据定义。", len(pluginsMeta))
 
 	pluginProducts = make(map[int64]*PluginInfo, len(pluginsMeta))
 	toolProducts = map[int64]*ToolInfo{}
@@ -303,6 +415,18 @@ func loadPluginProductMeta(ctx context.Context, basePath string) (err error) {
 		log.Printf("    [通过] 插件清单验证通过。")
 
 		docPath := path.Join(root, m.OpenapiDocFile)
+
+		docChecksum, err := sha256File(docPath)
+		if err != nil {
+			log.Printf("    [错误] %v，插件 (ID: %d) 已跳过。", err, m.PluginID)
+			continue
+		}
+		if m.OpenapiDocSHA256 != "" && !strings.EqualFold(docChecksum, m.OpenapiDocSHA256) {
+			log.Printf("    [安全错误] 插件 (ID: %d) 的 OpenAPI 文档 '%s' 校验和不匹配（期望 %s，实际 %s），文档可能被篡改或损坏，已跳过。",
+				m.PluginID, docPath, m.OpenapiDocSHA256, docChecksum)
+			continue
+		}
+
 		log.Printf("    [检查 3] 准备加载 OpenAPI 文档: %s", docPath)
 		loader := openapi3.NewLoader()
 		_doc, err := loader.LoadFromFile(docPath)
@@ -365,6 +489,12 @@ func loadPluginProductMeta(ctx context.Context, basePath string) (err error) {
 				continue
 			}
 
+			if t.OpenapiDocSHA256 != "" && !strings.EqualFold(docChecksum, t.OpenapiDocSHA256) {
+				log.Printf("        - [安全错误] 工具 (ToolID: %d) 的 OpenAPI 文档校验和不匹配（期望 %s，实际 %s），已跳过。",
+					t.ToolID, t.OpenapiDocSHA256, docChecksum)
+				continue
+			}
+
 			api := dto.UniqueToolAPI{
 				SubURL: t.SubURL,
 				Method: strings.ToUpper(t.Method),
@@ -379,18 +509,41 @@ func loadPluginProductMeta(ctx context.Context, basePath string) (err error) {
 				continue
 			}
 
+			toolVersion := m.Version
+			if t.Version != "" {
+				if !semver.IsValid(t.Version) {
+					log.Printf("        - [错误] 工具 (ToolID: %d) 的版本号 '%s' 非法，已跳过。", t.ToolID, t.Version)
+					continue
+				}
+				if semver.Compare(t.Version, m.Version) > 0 {
+					log.Printf("        - [错误] 工具 (ToolID: %d) 的版本号 '%s' 高于插件版本 '%s'，已跳过。", t.ToolID, t.Version, m.Version)
+					continue
+				}
+				toolVersion = t.Version
+			}
+
 			pi.ToolIDs = append(pi.ToolIDs, t.ToolID)
 
+			var rateLimitPerSecond, rateLimitPerMinute *int
+			if t.RateLimitPerSecond > 0 {
+				rateLimitPerSecond = ptr.Of(t.RateLimitPerSecond)
+			}
+			if t.RateLimitPerMinute > 0 {
+				rateLimitPerMinute = ptr.Of(t.RateLimitPerMinute)
+			}
+
 			toolProducts[t.ToolID] = &ToolInfo{
 				Info: &entity.ToolInfo{
-					ID:              t.ToolID,
-					PluginID:        m.PluginID,
-					Version:         ptr.Of(m.Version),
-					Method:          ptr.Of(t.Method),
-					SubURL:          ptr.Of(t.SubURL),
-					Operation:       op,
-					ActivatedStatus: ptr.Of(consts.ActivateTool),
-					DebugStatus:     ptr.Of(common.APIDebugStatus_DebugPassed),
+					ID:                 t.ToolID,
+					PluginID:           m.PluginID,
+					Version:            ptr.Of(toolVersion),
+					Method:             ptr.Of(t.Method),
+					SubURL:             ptr.Of(t.SubURL),
+					Operation:          op,
+					ActivatedStatus:    ptr.Of(consts.ActivateTool),
+					DebugStatus:        ptr.Of(common.APIDebugStatus_DebugPassed),
+					RateLimitPerSecond: rateLimitPerSecond,
+					RateLimitPerMinute: rateLimitPerMinute,
 				},
 			}
 		}
@@ -448,3 +601,167 @@ func checkPluginMetaInfo(ctx context.Context, m *pluginProductMeta) (continued b
 
 	return true
 }
+
+// PluginMetaValidationResult reports every error found while validating a single plugin entry
+// in plugin_meta.yaml. A deprecated plugin is still reported, with no errors, since deployment
+// tooling may want to confirm it was recognized and intentionally skipped.
+type PluginMetaValidationResult struct {
+	PluginID int64
+	Name     string
+	Errors   []string
+}
+
+// PluginMetaValidationReport is the structured result of ValidatePluginMeta, covering every
+// plugin defined in plugin_meta.yaml.
+type PluginMetaValidationReport struct {
+	Plugins []PluginMetaValidationResult
+}
+
+// HasErrors reports whether any plugin in the report failed validation.
+func (r *PluginMetaValidationReport) HasErrors() bool {
+	for _, p := range r.Plugins {
+		if len(p.Errors) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePluginMeta runs the same checks as loadPluginProductMeta (semver, manifest validation,
+// OpenAPI doc load+validate, tool-to-operation mapping) against basePath's plugin_meta.yaml,
+// without mutating the live toolProducts/pluginProducts registry. Unlike loadPluginProductMeta,
+// which logs and skips the first failing plugin/tool, this collects every error found across
+// every plugin into a single report, so operators can dry-run a new plugin_meta.yaml before
+// deploying it (e.g. from a CLI).
+func ValidatePluginMeta(ctx context.Context, basePath string) (*PluginMetaValidationReport, error) {
+	root := path.Join(basePath, pluginProductDirName())
+
+	metaFiles, err := resolvePluginMetaFiles(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var pluginsMeta []*pluginProductMeta
+	for _, metaFile := range metaFiles {
+		file, err := os.ReadFile(metaFile)
+		if err != nil {
+			return nil, fmt.Errorf("read file '%s' failed, err=%v", metaFile, err)
+		}
+
+		var fileMeta []*pluginProductMeta
+		if err = yaml.Unmarshal(file, &fileMeta); err != nil {
+			return nil, fmt.Errorf("unmarshal file '%s' failed, err=%v", metaFile, err)
+		}
+
+		pluginsMeta = append(pluginsMeta, fileMeta...)
+	}
+
+	report := &PluginMetaValidationReport{Plugins: make([]PluginMetaValidationResult, 0, len(pluginsMeta))}
+	seenPluginIDs := map[int64]bool{}
+
+	for _, m := range pluginsMeta {
+		result := PluginMetaValidationResult{PluginID: m.PluginID, Name: m.Manifest.NameForHuman}
+		addErr := func(format string, args ...any) {
+			result.Errors = append(result.Errors, fmt.Sprintf(format, args...))
+		}
+
+		if m.Deprecated {
+			report.Plugins = append(report.Plugins, result)
+			continue
+		}
+
+		if !semver.IsValid(m.Version) {
+			addErr("invalid plugin version '%s'", m.Version)
+		}
+		if m.PluginID <= 0 {
+			addErr("invalid plugin id '%d'", m.PluginID)
+		}
+		if seenPluginIDs[m.PluginID] {
+			addErr("duplicate plugin id '%d'", m.PluginID)
+		}
+		seenPluginIDs[m.PluginID] = true
+		if m.PluginType != common.PluginType_PLUGIN {
+			addErr("invalid plugin type '%s'", m.PluginType)
+		}
+
+		if err = m.Manifest.Validate(true); err != nil {
+			addErr("manifest validation failed: %v", err)
+		}
+
+		docPath := path.Join(root, m.OpenapiDocFile)
+
+		docChecksum, err := sha256File(docPath)
+		if err != nil {
+			addErr("%v", err)
+			report.Plugins = append(report.Plugins, result)
+			continue
+		}
+		if m.OpenapiDocSHA256 != "" && !strings.EqualFold(docChecksum, m.OpenapiDocSHA256) {
+			addErr("openapi doc '%s' sha256 mismatch: expected '%s', got '%s'", docPath, m.OpenapiDocSHA256, docChecksum)
+			report.Plugins = append(report.Plugins, result)
+			continue
+		}
+
+		loader := openapi3.NewLoader()
+		_doc, err := loader.LoadFromFile(docPath)
+		if err != nil {
+			addErr("load openapi doc '%s' failed: %v", docPath, err)
+			report.Plugins = append(report.Plugins, result)
+			continue
+		}
+
+		doc := ptr.Of(model.Openapi3T(*_doc))
+		if err = doc.Validate(ctx); err != nil {
+			addErr("openapi doc '%s' content validation failed: %v", m.OpenapiDocFile, err)
+			report.Plugins = append(report.Plugins, result)
+			continue
+		}
+
+		apis := make(map[dto.UniqueToolAPI]*model.Openapi3Operation, len(doc.Paths))
+		for subURL, pathItem := range doc.Paths {
+			for method, op := range pathItem.Operations() {
+				apis[dto.UniqueToolAPI{SubURL: subURL, Method: strings.ToUpper(method)}] = model.NewOpenapi3Operation(op)
+			}
+		}
+
+		seenToolIDs := map[int64]bool{}
+		for _, t := range m.Tools {
+			if t.Deprecated {
+				continue
+			}
+			if seenToolIDs[t.ToolID] {
+				addErr("duplicate tool id '%d'", t.ToolID)
+				continue
+			}
+			seenToolIDs[t.ToolID] = true
+
+			if t.OpenapiDocSHA256 != "" && !strings.EqualFold(docChecksum, t.OpenapiDocSHA256) {
+				addErr("tool (id: %d) openapi doc sha256 mismatch: expected '%s', got '%s'", t.ToolID, t.OpenapiDocSHA256, docChecksum)
+				continue
+			}
+
+			api := dto.UniqueToolAPI{SubURL: t.SubURL, Method: strings.ToUpper(t.Method)}
+			op, ok := apis[api]
+			if !ok {
+				addErr("tool (id: %d) api '[%s]:%s' not found in openapi doc '%s'", t.ToolID, api.Method, api.SubURL, docPath)
+				continue
+			}
+			if err = op.Validate(ctx); err != nil {
+				addErr("tool (id: %d) operation validation failed: %v", t.ToolID, err)
+				continue
+			}
+
+			if t.Version != "" {
+				if !semver.IsValid(t.Version) {
+					addErr("tool (id: %d) has invalid version '%s'", t.ToolID, t.Version)
+				} else if semver.Compare(t.Version, m.Version) > 0 {
+					addErr("tool (id: %d) version '%s' exceeds plugin version '%s'", t.ToolID, t.Version, m.Version)
+				}
+			}
+		}
+
+		report.Plugins = append(report.Plugins, result)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-studio/backend/crossdomain/plugin/model"
+	"github.com/coze-dev/coze-studio/backend/pkg/lang/ptr"
+)
+
+func TestGetPluginProduct_MutationDoesNotAffectRegistry(t *testing.T) {
+	pluginProducts = map[int64]*PluginInfo{
+		1: {
+			Info: &model.PluginInfo{
+				ID:      1,
+				Version: ptr.Of("v1.0.0"),
+			},
+			ToolIDs: []int64{100},
+		},
+	}
+	defer func() { pluginProducts = nil }()
+
+	pl, ok := GetPluginProduct(1)
+	assert.True(t, ok)
+
+	pl.Info.Version = ptr.Of("tampered")
+	pl.ToolIDs[0] = 999
+
+	original, ok := GetPluginProduct(1)
+	assert.True(t, ok)
+	assert.Equal(t, "v1.0.0", *original.Info.Version)
+	assert.Equal(t, int64(100), original.ToolIDs[0])
+}
+
+func TestMGetPluginProducts_MutationDoesNotAffectRegistry(t *testing.T) {
+	pluginProducts = map[int64]*PluginInfo{
+		1: {Info: &model.PluginInfo{ID: 1, Version: ptr.Of("v1.0.0")}, ToolIDs: []int64{100}},
+	}
+	defer func() { pluginProducts = nil }()
+
+	plugins := MGetPluginProducts([]int64{1})
+	assert.Len(t, plugins, 1)
+	plugins[0].Info.Version = ptr.Of("tampered")
+
+	original, ok := GetPluginProduct(1)
+	assert.True(t, ok)
+	assert.Equal(t, "v1.0.0", *original.Info.Version)
+}
+
+func TestGetAllPluginProducts_MutationDoesNotAffectRegistry(t *testing.T) {
+	pluginProducts = map[int64]*PluginInfo{
+		1: {Info: &model.PluginInfo{ID: 1, Version: ptr.Of("v1.0.0")}, ToolIDs: []int64{100}},
+	}
+	defer func() { pluginProducts = nil }()
+
+	plugins := GetAllPluginProducts()
+	assert.Len(t, plugins, 1)
+	plugins[0].Info.Version = ptr.Of("tampered")
+
+	original, ok := GetPluginProduct(1)
+	assert.True(t, ok)
+	assert.Equal(t, "v1.0.0", *original.Info.Version)
+}
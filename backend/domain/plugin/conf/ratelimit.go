@@ -0,0 +1,95 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conf
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coze-dev/coze-studio/backend/pkg/errorx"
+	"github.com/coze-dev/coze-studio/backend/types/errno"
+)
+
+// toolRateWindow is a fixed-window call counter for one tool and one window length, reset once
+// the window elapses. Mirrors connectorRateWindow in application/workflow/connector_registry.go.
+type toolRateWindow struct {
+	mu    sync.Mutex
+	start time.Time
+	count int
+}
+
+func (w *toolRateWindow) checkAndIncrement(limit int, window time.Duration) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(w.start) >= window {
+		w.start = now
+		w.count = 0
+	}
+	w.count++
+
+	return w.count <= limit
+}
+
+var (
+	toolRateWindowsMu sync.Mutex
+	toolRateWindows   = map[int64]*[2]*toolRateWindow{}
+)
+
+func getToolRateWindows(toolID int64) *[2]*toolRateWindow {
+	toolRateWindowsMu.Lock()
+	defer toolRateWindowsMu.Unlock()
+
+	windows, ok := toolRateWindows[toolID]
+	if !ok {
+		windows = &[2]*toolRateWindow{{}, {}}
+		toolRateWindows[toolID] = windows
+	}
+
+	return windows
+}
+
+// CheckToolRateLimit throttles calls to toolID against the per-second/per-minute limits
+// configured on its plugin product metadata, shared by every caller that invokes a tool outside
+// its normal path (workflow nodes, test-invocation, etc). Tools with no configured limit, or
+// that aren't in the plugin product registry, are never throttled.
+func CheckToolRateLimit(toolID int64) error {
+	productTool, ok := GetToolProduct(toolID)
+	if !ok {
+		return nil
+	}
+
+	windows := getToolRateWindows(toolID)
+
+	if limit := productTool.Info.RateLimitPerSecond; limit != nil {
+		if !windows[0].checkAndIncrement(*limit, time.Second) {
+			return errorx.New(errno.ErrToolRateLimited,
+				errorx.KV("tool_id", fmt.Sprintf("%d", toolID)))
+		}
+	}
+
+	if limit := productTool.Info.RateLimitPerMinute; limit != nil {
+		if !windows[1].checkAndIncrement(*limit, time.Minute) {
+			return errorx.New(errno.ErrToolRateLimited,
+				errorx.KV("tool_id", fmt.Sprintf("%d", toolID)))
+		}
+	}
+
+	return nil
+}
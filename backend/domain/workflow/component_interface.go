@@ -18,6 +18,7 @@ package workflow
 
 import (
 	"context"
+	"time"
 
 	"github.com/cloudwego/eino/components/tool"
 	"github.com/cloudwego/eino/compose"
@@ -42,10 +43,21 @@ type Executable interface {
 	GetExecution(ctx context.Context, wfExe *entity.WorkflowExecution, includeNodes bool) (*entity.WorkflowExecution, error)
 	GetNodeExecution(ctx context.Context, exeID int64, nodeID string) (*entity.NodeExecution, *entity.NodeExecution, error)
 	GetLatestTestRunInput(ctx context.Context, wfID int64, userID int64) (*entity.NodeExecution, bool, error)
+	// GetLatestSuccessfulExecution and GetLatestFailedExecution return the most recent execution
+	// of wfID by userID with the respective outcome, so the debug UI can offer "re-run last good
+	// inputs" and "inspect last failure" separately from GetLatestTestRunInput's outcome-agnostic
+	// latest run.
+	GetLatestSuccessfulExecution(ctx context.Context, wfID int64, userID int64) (*entity.WorkflowExecution, bool, error)
+	GetLatestFailedExecution(ctx context.Context, wfID int64, userID int64) (*entity.WorkflowExecution, bool, error)
 	GetLatestNodeDebugInput(ctx context.Context, wfID int64, nodeID string, userID int64) (
 		*entity.NodeExecution, *entity.NodeExecution, bool, error)
+	InferLatestTestRunOutputSchema(ctx context.Context, wfID int64, userID int64) ([]*vo.Variable, error)
 
 	Cancel(ctx context.Context, wfExeID int64, wfID, spaceID int64) error
+
+	// GetVariantStats aggregates wfID's executions created within [from, to) by their
+	// ExperimentVariant label, for A/B comparison of success rate and token usage.
+	GetVariantStats(ctx context.Context, wfID int64, from, to time.Time) ([]*entity.VariantStats, error)
 }
 
 type AsTool interface {
@@ -98,10 +110,37 @@ type CancelSignalStore interface {
 	GetWorkflowCancelFlag(ctx context.Context, wfExeID int64) (bool, error)
 }
 
+// EditLockStore backs the optional explicit draft-edit lock: a short-TTL lease that a single
+// user holds at a time, on top of the commit-ID based optimistic concurrency check that always
+// applies. AcquireEditLock is re-entrant for the current holder, refreshing the TTL instead of
+// failing.
+type EditLockStore interface {
+	AcquireEditLock(ctx context.Context, workflowID, holderID int64, ttl time.Duration) (bool, error)
+	ReleaseEditLock(ctx context.Context, workflowID, holderID int64) error
+	GetEditLock(ctx context.Context, workflowID int64) (*entity.EditLock, bool, error)
+}
+
+// ResultCacheStore backs the opt-in OpenAPIRun result cache: callers of a workflow with
+// Meta.CacheEnabled set get back a cached output for a repeated (version, normalized input)
+// pair instead of a fresh execution, until the entry's TTL elapses.
+type ResultCacheStore interface {
+	GetCachedResult(ctx context.Context, key string) (result string, ok bool, err error)
+	SetCachedResult(ctx context.Context, key string, result string, ttl time.Duration) error
+}
+
+// TestRunPresetStore backs named, reusable test-run input sets a user saves for a workflow, on
+// top of the single most-recent input already tracked by ExecuteHistoryStore.
+type TestRunPresetStore interface {
+	SaveTestRunPreset(ctx context.Context, preset *entity.TestRunPreset) error
+	ListTestRunPresets(ctx context.Context, workflowID, userID int64) ([]*entity.TestRunPreset, error)
+	DeleteTestRunPreset(ctx context.Context, workflowID, userID int64, name string) error
+}
+
 type ExecuteHistoryStore interface {
 	CreateWorkflowExecution(ctx context.Context, execution *entity.WorkflowExecution) error
 	UpdateWorkflowExecution(ctx context.Context, execution *entity.WorkflowExecution, allowedStatus []entity.WorkflowExecuteStatus) (int64, entity.WorkflowExecuteStatus, error)
 	TryLockWorkflowExecution(ctx context.Context, wfExeID, resumingEventID int64) (bool, entity.WorkflowExecuteStatus, error)
+	IncrementInterruptCount(ctx context.Context, wfExeID int64) (int32, error)
 	GetWorkflowExecution(ctx context.Context, id int64) (*entity.WorkflowExecution, bool, error)
 	CreateNodeExecution(ctx context.Context, execution *entity.NodeExecution) error
 	UpdateNodeExecution(ctx context.Context, execution *entity.NodeExecution) error
@@ -113,8 +152,12 @@ type ExecuteHistoryStore interface {
 		[]*entity.NodeExecution, error)
 	SetTestRunLatestExeID(ctx context.Context, wfID int64, uID int64, exeID int64) error
 	GetTestRunLatestExeID(ctx context.Context, wfID int64, uID int64) (int64, error)
+	GetLatestWorkflowExecutionByStatus(ctx context.Context, wfID int64, uID int64, status entity.WorkflowExecuteStatus) (*entity.WorkflowExecution, bool, error)
 	SetNodeDebugLatestExeID(ctx context.Context, wfID int64, nodeID string, uID int64, exeID int64) error
 	GetNodeDebugLatestExeID(ctx context.Context, wfID int64, nodeID string, uID int64) (int64, error)
+	// GetVariantStats aggregates wfID's executions created within [from, to) by their
+	// ExperimentVariant label, for A/B comparison of success rate and token usage.
+	GetVariantStats(ctx context.Context, wfID int64, from, to time.Time) ([]*entity.VariantStats, error)
 }
 
 type ToolFromWorkflow interface {
@@ -148,6 +191,8 @@ type ConversationRepository interface {
 }
 type WorkflowConfig interface {
 	GetNodeOfCodeConfig() *config.NodeOfCodeConfig
+	GetSpaceExecutionQuotas() map[int64]int64
+	GetPIIScrub() *config.PIIScrubConfig
 }
 
 type Suggester interface {
@@ -18,12 +18,43 @@ package config
 
 type WorkflowConfig struct {
 	NodeOfCodeConfig *NodeOfCodeConfig `yaml:"NodeOfCodeConfig"`
+	// SpaceExecutionQuotas configures, per space ID, the daily workflow execution cap enforced by
+	// checkAndIncrementExecutionQuota. A space with no entry here has no cap.
+	SpaceExecutionQuotas map[int64]int64 `yaml:"SpaceExecutionQuotas"`
+	// PIIScrub configures scrubbing of PII out of stored execution inputs/outputs. Nil disables
+	// scrubbing everywhere.
+	PIIScrub *PIIScrubConfig `yaml:"PIIScrub"`
 }
 
 func (w *WorkflowConfig) GetNodeOfCodeConfig() *NodeOfCodeConfig {
 	return w.NodeOfCodeConfig
 }
 
+func (w *WorkflowConfig) GetSpaceExecutionQuotas() map[int64]int64 {
+	return w.SpaceExecutionQuotas
+}
+
+func (w *WorkflowConfig) GetPIIScrub() *PIIScrubConfig {
+	return w.PIIScrub
+}
+
+// PIIScrubConfig is the YAML-configurable counterpart of repo.PIIScrubConfig: the same set of
+// rules, plus the list of spaces they should be enforced for, so the feature can be turned on by
+// an operator without a code change.
+type PIIScrubConfig struct {
+	Rules []*PIIScrubRule `yaml:"Rules"`
+	// Replacement is substituted for anything a rule matches. Defaults to "[REDACTED]".
+	Replacement string `yaml:"Replacement"`
+	// EnabledSpaceIDs lists the spaces scrubbing is enforced for. Spaces not listed are unaffected.
+	EnabledSpaceIDs []int64 `yaml:"EnabledSpaceIDs"`
+}
+
+// PIIScrubRule mirrors repo.PIIScrubRule. Exactly one of FieldName or Pattern should be set.
+type PIIScrubRule struct {
+	FieldName string `yaml:"FieldName"`
+	Pattern   string `yaml:"Pattern"`
+}
+
 type NodeOfCodeConfig struct {
 	SupportThirdPartModules []string `yaml:"SupportThirdPartModules"`
 }
@@ -36,6 +36,10 @@ type InterruptEvent struct {
 	EventType     InterruptEventType `json:"event_type"`
 	NodePath      []string           `json:"node_path,omitempty"`
 	Popped        bool               `json:"popped,omitempty"`
+	// InputSchema describes the shape of the ResumeData this event expects, as a JSON schema
+	// string, when the interrupting node is able to derive one from its config (e.g. question
+	// nodes describing a free-text answer or a fixed set of choices). Empty when not derivable.
+	InputSchema string `json:"input_schema,omitempty"`
 
 	// index within composite node -> interrupt info for that index
 	// TODO: separate the following fields with InterruptEvent
@@ -50,6 +54,9 @@ const (
 	InterruptEventQuestion = workflow.EventType_Question
 	InterruptEventInput    = workflow.EventType_InputNode
 	InterruptEventLLM      = 100 // interrupt events emitted by LLM node, which are emitted by nodes within workflow tools
+	// InterruptEventBreakpoint marks a pause synthesized by a debug TestRun breakpoint (see
+	// ExecuteConfig.Breakpoints), rather than an interrupt raised by the node itself.
+	InterruptEventBreakpoint = 101
 )
 
 func (i *InterruptEvent) String() string {
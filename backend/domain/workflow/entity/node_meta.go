@@ -59,6 +59,12 @@ type NodeTypeMeta struct {
 	EnUSName        string `json:"en_us_name,omitempty"`
 	EnUSDescription string `json:"en_us_description,omitempty"`
 
+	// Deprecated marks a node type that still exists for backward compatibility but should no
+	// longer be used in new canvases. ReplacedBy optionally names the node type users should
+	// migrate to.
+	Deprecated bool     `json:"deprecated,omitempty"`
+	ReplacedBy NodeType `json:"replaced_by,omitempty"`
+
 	ExecutableMeta
 }
 
@@ -22,14 +22,22 @@ import (
 	"github.com/coze-dev/coze-studio/backend/api/model/app/bot_common"
 	"github.com/coze-dev/coze-studio/backend/api/model/workflow"
 	"github.com/coze-dev/coze-studio/backend/pkg/i18n"
-	"github.com/coze-dev/coze-studio/backend/pkg/lang/ternary"
 )
 
+// CurrentSchemaVersion is the canvas schema format produced by this version of the backend.
+// A canvas whose SchemaVersion is below this is legacy and can be forward-migrated via the
+// internal/canvas/migration package.
+const CurrentSchemaVersion = 1
+
 // Canvas is the definition of FRONTEND schema for a workflow.
 type Canvas struct {
 	Nodes    []*Node `json:"nodes"`
 	Edges    []*Edge `json:"edges"`
 	Versions any     `json:"versions"`
+
+	// SchemaVersion identifies the canvas schema format this document was saved in. Absent or
+	// below CurrentSchemaVersion marks a legacy canvas that migration.Migrate can bring forward.
+	SchemaVersion int `json:"schemaVersion,omitempty"`
 }
 
 // Node represents a node within a workflow canvas.
@@ -242,6 +250,7 @@ type FCParam struct {
 			FCSetting       *struct {
 				RequestParameters  []*workflow.APIParameter `json:"request_params"`
 				ResponseParameters []*workflow.APIParameter `json:"response_params"`
+				ResponseStyle      *workflow.ResponseStyle  `json:"response_style,omitempty"`
 			} `json:"fc_setting,omitempty"`
 		} `json:"workflowList,omitempty"`
 	} `json:"workflowFCParam,omitempty"`
@@ -497,6 +506,118 @@ type Variable struct {
 	// DefaultValue configures the 'default value' if this field is missing in input.
 	// Effective only in Entry node.
 	DefaultValue any `json:"defaultValue,omitempty"`
+
+	// Enum restricts this field's value to one of the given strings, if non-empty.
+	Enum []string `json:"enum,omitempty"`
+
+	// Min and Max constrain a numeric field's value, inclusive.
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	// MinLength and MaxLength constrain a string field's length, inclusive.
+	MinLength *int `json:"minLength,omitempty"`
+	MaxLength *int `json:"maxLength,omitempty"`
+	// Pattern, if set, is a regular expression a string field's value must match.
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+// ExampleValue produces a type-appropriate placeholder value for this field, suitable for
+// building a copy-paste sample request body (e.g. for OpenAPIRun).
+func (v *Variable) ExampleValue() any {
+	switch v.Type {
+	case VariableTypeString:
+		return "example"
+	case VariableTypeInteger:
+		return int64(0)
+	case VariableTypeFloat:
+		return float64(0)
+	case VariableTypeBoolean:
+		return false
+	case VariableTypeList:
+		elem, ok := v.Schema.(*Variable)
+		if !ok || elem == nil {
+			return []any{}
+		}
+		return []any{elem.ExampleValue()}
+	case VariableTypeObject:
+		fields, ok := v.Schema.([]*Variable)
+		if !ok {
+			return map[string]any{}
+		}
+		obj := make(map[string]any, len(fields))
+		for _, f := range fields {
+			obj[f.Name] = f.ExampleValue()
+		}
+		return obj
+	default:
+		return nil
+	}
+}
+
+// ToJSONSchema converts this field into a JSON Schema document, carrying over its
+// constraints (enum, min/max, min/max length, pattern) and recursing into object
+// properties or array items. Used for endpoints that expose a workflow's input/output
+// contract for validation and codegen (e.g. GetWorkflowOutputSchema).
+func (v *Variable) ToJSONSchema() map[string]any {
+	s := make(map[string]any)
+
+	switch v.Type {
+	case VariableTypeString:
+		s["type"] = "string"
+	case VariableTypeInteger:
+		s["type"] = "integer"
+	case VariableTypeFloat:
+		s["type"] = "number"
+	case VariableTypeBoolean:
+		s["type"] = "boolean"
+	case VariableTypeList:
+		s["type"] = "array"
+		if elem, ok := v.Schema.(*Variable); ok && elem != nil {
+			s["items"] = elem.ToJSONSchema()
+		}
+	case VariableTypeObject:
+		s["type"] = "object"
+		fields, _ := v.Schema.([]*Variable)
+		properties := make(map[string]any, len(fields))
+		required := make([]string, 0, len(fields))
+		for _, f := range fields {
+			properties[f.Name] = f.ToJSONSchema()
+			if f.Required {
+				required = append(required, f.Name)
+			}
+		}
+		s["properties"] = properties
+		if len(required) > 0 {
+			s["required"] = required
+		}
+	}
+
+	if v.Description != "" {
+		s["description"] = v.Description
+	}
+	if len(v.Enum) > 0 {
+		enum := make([]any, len(v.Enum))
+		for i, e := range v.Enum {
+			enum[i] = e
+		}
+		s["enum"] = enum
+	}
+	if v.Min != nil {
+		s["minimum"] = *v.Min
+	}
+	if v.Max != nil {
+		s["maximum"] = *v.Max
+	}
+	if v.MinLength != nil {
+		s["minLength"] = *v.MinLength
+	}
+	if v.MaxLength != nil {
+		s["maxLength"] = *v.MaxLength
+	}
+	if v.Pattern != nil {
+		s["pattern"] = *v.Pattern
+	}
+
+	return s
 }
 
 type BlockInput struct {
@@ -954,10 +1075,190 @@ const defaultEnUSInitCanvasJsonSchemaChat = `{
 	}]
 }`
 
+const defaultJaJPInitCanvasJsonSchema = `{
+ "nodes": [
+  {
+   "id": "100001",
+   "type": "1",
+   "meta": {
+    "position": {
+     "x": 0,
+     "y": 0
+    }
+   },
+   "data": {
+    "nodeMeta": {
+     "description": "ワークフローの開始ノード。ワークフローの起動に必要な情報を設定します。",
+     "icon": "https://lf3-static.bytednsdoc.com/obj/eden-cn/dvsmryvd_avi_dvsm/ljhwZthlaukjlkulzlp/icon/icon-Start.png",
+     "subTitle": "",
+     "title": "開始"
+    },
+    "outputs": [
+     {
+      "type": "string",
+      "name": "input",
+      "required": false
+     }
+    ],
+    "trigger_parameters": [
+     {
+      "type": "string",
+      "name": "input",
+      "required": false
+     }
+    ]
+   }
+  },
+  {
+   "id": "900001",
+   "type": "2",
+   "meta": {
+    "position": {
+     "x": 1000,
+     "y": 0
+    }
+   },
+   "data": {
+    "nodeMeta": {
+     "description": "ワークフローの終了ノード。ワークフロー実行後の結果情報を返します。",
+     "icon": "https://lf3-static.bytednsdoc.com/obj/eden-cn/dvsmryvd_avi_dvsm/ljhwZthlaukjlkulzlp/icon/icon-End.png",
+     "subTitle": "",
+     "title": "終了"
+    },
+    "inputs": {
+     "terminatePlan": "returnVariables",
+     "inputParameters": [
+      {
+       "name": "output",
+       "input": {
+        "type": "string",
+        "value": {
+         "type": "ref",
+         "content": {
+          "source": "block-output",
+          "blockID": "",
+          "name": ""
+         }
+        }
+       }
+      }
+     ]
+    }
+   }
+  }
+ ],
+ "edges": [],
+ "versions": {
+  "loop": "v2"
+ }
+}`
+
+const defaultJaJPInitCanvasJsonSchemaChat = `{
+	"nodes": [{
+		"id": "100001",
+		"type": "1",
+		"meta": {
+			"position": {
+				"x": 0,
+				"y": 0
+			}
+		},
+		"data": {
+			"outputs": [{
+				"type": "string",
+				"name": "USER_INPUT",
+				"required": true
+			}, {
+				"type": "string",
+				"name": "CONVERSATION_NAME",
+				"required": false,
+				"description": "このリクエストに紐づくコンバセーション。メッセージは自動的に書き込まれ、対話履歴はこのコンバセーションから読み込まれます。",
+				"defaultValue": "%s"
+			}],
+			"nodeMeta": {
+				"title": "開始",
+				"icon": "https://lf3-static.bytednsdoc.com/obj/eden-cn/dvsmryvd_avi_dvsm/ljhwZthlaukjlkulzlp/icon/icon-Start.png",
+				"description": "ワークフローの開始ノード。ワークフローの起動に必要な情報を設定します。",
+				"subTitle": ""
+			}
+		}
+	}, {
+		"id": "900001",
+		"type": "2",
+		"meta": {
+			"position": {
+				"x": 1000,
+				"y": 0
+			}
+		},
+		"data": {
+			"nodeMeta": {
+				"title": "終了",
+				"icon": "https://lf3-static.bytednsdoc.com/obj/eden-cn/dvsmryvd_avi_dvsm/ljhwZthlaukjlkulzlp/icon/icon-End.png",
+				"description": "ワークフローの終了ノード。ワークフロー実行後の結果情報を返します。",
+				"subTitle": ""
+			},
+			"inputs": {
+				"terminatePlan": "useAnswerContent",
+				"streamingOutput": true,
+				"inputParameters": [{
+					"name": "output",
+					"input": {
+						"type": "string",
+						"value": {
+							"type": "ref"
+						}
+					}
+				}]
+			}
+		}
+	}]
+}`
+
+// defaultInitCanvasJsonSchemaByLocale and defaultInitCanvasJsonSchemaChatByLocale hold every
+// locale this tree has a translated default canvas for. GetDefaultInitCanvasJsonSchema and
+// GetDefaultInitCanvasJsonSchemaChat fall back to English for any locale missing here, so new
+// locales can be onboarded by adding an entry without touching the lookup logic.
+var defaultInitCanvasJsonSchemaByLocale = map[i18n.Locale]string{
+	i18n.LocaleEN: defaultEnUSInitCanvasJsonSchema,
+	i18n.LocaleZH: defaultZhCNInitCanvasJsonSchema,
+	i18n.LocaleJA: defaultJaJPInitCanvasJsonSchema,
+}
+
+var defaultInitCanvasJsonSchemaChatByLocale = map[i18n.Locale]string{
+	i18n.LocaleEN: defaultEnUSInitCanvasJsonSchemaChat,
+	i18n.LocaleZH: defaultZhCNInitCanvasJsonSchemaChat,
+	i18n.LocaleJA: defaultJaJPInitCanvasJsonSchemaChat,
+}
+
+// defaultConversationNameByLocale localizes the fallback conversation name used when the
+// caller doesn't supply one (see CreateWorkflow).
+var defaultConversationNameByLocale = map[i18n.Locale]string{
+	i18n.LocaleEN: "Default",
+	i18n.LocaleZH: "默认",
+	i18n.LocaleJA: "デフォルト",
+}
+
 func GetDefaultInitCanvasJsonSchema(locale i18n.Locale) string {
-	return ternary.IFElse(locale == i18n.LocaleEN, defaultEnUSInitCanvasJsonSchema, defaultZhCNInitCanvasJsonSchema)
+	if schema, ok := defaultInitCanvasJsonSchemaByLocale[locale]; ok {
+		return schema
+	}
+	return defaultEnUSInitCanvasJsonSchema
 }
 
 func GetDefaultInitCanvasJsonSchemaChat(locale i18n.Locale, name string) string {
-	return ternary.IFElse(locale == i18n.LocaleEN, fmt.Sprintf(defaultEnUSInitCanvasJsonSchemaChat, name), fmt.Sprintf(defaultZhCNInitCanvasJsonSchemaChat, name))
+	tmpl, ok := defaultInitCanvasJsonSchemaChatByLocale[locale]
+	if !ok {
+		tmpl = defaultEnUSInitCanvasJsonSchemaChat
+	}
+	return fmt.Sprintf(tmpl, name)
+}
+
+// GetDefaultConversationName returns the localized name to use for the default chat flow
+// conversation when the caller didn't provide one, falling back to English.
+func GetDefaultConversationName(locale i18n.Locale) string {
+	if name, ok := defaultConversationNameByLocale[locale]; ok {
+		return name
+	}
+	return defaultConversationNameByLocale[i18n.LocaleEN]
 }
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vo
+
+// WorkflowComplexity is a governance-facing score summarizing how complex a workflow's canvas
+// is, along with the sub-scores that drive it, so a caller can see what to simplify rather than
+// just a single opaque number.
+type WorkflowComplexity struct {
+	// Score is the overall complexity score: NodeCount + 5*BranchingDepth + 5*SubWorkflowDepth +
+	// 2*ExternalReferenceCount.
+	Score int
+
+	// NodeCount is the total number of nodes in the canvas, including nodes nested inside
+	// composite nodes (batch/loop) and inside sub-workflows.
+	NodeCount int
+	// BranchingDepth is the deepest nesting of composite nodes (batch/loop) in the canvas.
+	BranchingDepth int
+	// SubWorkflowDepth is the deepest chain of sub-workflow-calls-sub-workflow in the canvas.
+	SubWorkflowDepth int
+	// ExternalReferenceCount is the number of plugin, knowledge base and database references
+	// found across the canvas and any sub-workflows it calls.
+	ExternalReferenceCount int
+}
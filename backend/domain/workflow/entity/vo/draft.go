@@ -68,3 +68,13 @@ type DraftMeta struct {
 	Timestamp      time.Time
 	IsSnapshot     bool // if true, this is a snapshot of a previous draft content, not the latest draft
 }
+
+// MaxDraftSnapshots caps how many autosave snapshots are retained per workflow; saving a new
+// one prunes the oldest beyond this count.
+const MaxDraftSnapshots = 20
+
+// DraftSnapshotMeta describes a single autosave draft snapshot, without its canvas content.
+type DraftSnapshotMeta struct {
+	CommitID  string
+	CreatedAt time.Time
+}
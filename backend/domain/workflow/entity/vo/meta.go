@@ -48,6 +48,12 @@ type Meta struct {
 	DeletedAt              *time.Time
 	HasPublished           bool
 	LatestPublishedVersion *string
+
+	// CacheEnabled opts this workflow into caching OpenAPIRun results, keyed by published version
+	// and normalized input, for CacheTTLSeconds. Only meaningful for workflows that are pure
+	// functions of their input; streaming runs always bypass the cache.
+	CacheEnabled    bool
+	CacheTTLSeconds int32
 }
 
 type MetaCreate struct {
@@ -69,6 +75,8 @@ type MetaUpdate struct {
 	HasPublished           *bool
 	LatestPublishedVersion *string
 	WorkflowMode           *Mode
+	CacheEnabled           *bool
+	CacheTTLSeconds        *int32
 }
 
 type MetaQuery struct {
@@ -82,4 +90,5 @@ type MetaQuery struct {
 	NeedTotalNumber bool
 	DescByUpdate    bool
 	Mode            *workflow.WorkflowMode
+	Tag             *Tag
 }
@@ -26,6 +26,7 @@ import (
 
 	"github.com/coze-dev/coze-studio/backend/bizpkg/debugutil"
 	"github.com/coze-dev/coze-studio/backend/pkg/errorx"
+	"github.com/coze-dev/coze-studio/backend/pkg/i18n"
 	"github.com/coze-dev/coze-studio/backend/pkg/sonic"
 	"github.com/coze-dev/coze-studio/backend/types/errno"
 )
@@ -71,6 +72,17 @@ type NamedTypeInfo struct {
 	Required     bool             `json:"required,omitempty"`
 	Desc         string           `json:"desc,omitempty"`
 	Properties   []*NamedTypeInfo `json:"properties,omitempty"`
+	// Enum restricts this parameter's value to one of the given strings. Only meaningful
+	// for non-object, non-array types.
+	Enum []string `json:"enum,omitempty"`
+	// Min and Max constrain a numeric parameter's value, inclusive.
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	// MinLength and MaxLength constrain a string parameter's length, inclusive.
+	MinLength *int `json:"min_length,omitempty"`
+	MaxLength *int `json:"max_length,omitempty"`
+	// Pattern, if set, is a regular expression a string parameter's value must match.
+	Pattern *string `json:"pattern,omitempty"`
 }
 
 type ErrorLevel string
@@ -88,6 +100,10 @@ type WorkflowError interface {
 	OpenAPICode() int
 	AppendDebug(exeID, spaceID, workflowID int64) WorkflowError
 	ChangeErrLevel(newLevel ErrorLevel) WorkflowError
+	// LocalizedMsg returns the error message translated for ctx's locale. Only a subset of
+	// error codes have a translation registered in localizedMessages; everything else falls
+	// back to Msg(), same as before this method existed.
+	LocalizedMsg(ctx context.Context) string
 }
 
 type wfErr struct {
@@ -136,6 +152,42 @@ func (w *wfErr) OpenAPICode() int {
 	return errno.CodeForOpenAPI(w)
 }
 
+// localizedMessages carries translations for error codes whose registered message template has
+// no '{placeholder}' tokens. Placeholder substitution in pkg/errorx happens once, destructively,
+// at construction time, so a per-instance value (e.g. {id}, {cause}) can no longer be recovered
+// here to re-render it in another locale. Codes with placeholders simply aren't in this map and
+// fall back to Msg() below.
+var localizedMessages = map[i18n.Locale]map[int32]string{
+	i18n.LocaleZH: {
+		errno.ErrWorkflowCanceledByUser: "工作流已被用户取消",
+		errno.ErrNodeTimeout:            "节点执行超时",
+		errno.ErrWorkflowTimeout:        "工作流执行超时，请检查是否存在长耗时操作，尝试优化或稍后重试",
+		errno.ErrInterruptNotSupported:  "同步请求不支持中断，如需使用中断功能请切换为异步请求",
+	},
+}
+
+func (w *wfErr) LocalizedMsg(ctx context.Context) string {
+	return LocalizedErrorMsg(ctx, w.Code(), w.Msg())
+}
+
+// LocalizedErrorMsg looks up a translation for errCode in ctx's locale, falling back to
+// fallbackMsg when no translation is registered. It exists alongside WorkflowError.LocalizedMsg
+// for call sites that only have a persisted error code and message (e.g. a FailReason already
+// loaded from storage) rather than a live WorkflowError instance.
+func LocalizedErrorMsg(ctx context.Context, errCode int32, fallbackMsg string) string {
+	byCode, ok := localizedMessages[i18n.GetLocale(ctx)]
+	if !ok {
+		return fallbackMsg
+	}
+
+	msg, ok := byCode[errCode]
+	if !ok {
+		return fallbackMsg
+	}
+
+	return msg
+}
+
 func (w *wfErr) AppendDebug(exeID, spaceID, workflowID int64) WorkflowError {
 	w.exeID = exeID
 	w.spaceID = spaceID
@@ -340,9 +392,15 @@ func (n *NamedTypeInfo) ToVariable() (*Variable, error) {
 	}
 
 	v := &Variable{
-		Name:     n.Name,
-		Type:     variableType,
-		Required: n.Required,
+		Name:      n.Name,
+		Type:      variableType,
+		Required:  n.Required,
+		Enum:      n.Enum,
+		Min:       n.Min,
+		Max:       n.Max,
+		MinLength: n.MinLength,
+		MaxLength: n.MaxLength,
+		Pattern:   n.Pattern,
 	}
 
 	if n.Type == DataTypeFile && n.FileType != nil {
@@ -564,6 +622,22 @@ type NodeProperty struct {
 	SubWorkflow         map[string]*NodeProperty
 }
 
+// GlobalVariableUsage describes a single node that references a global variable,
+// as reported by FindGlobalVariableUsages.
+type GlobalVariableUsage struct {
+	WorkflowID int64
+	NodeID     string
+	NodeName   string
+}
+
+// DatabaseUsage describes a single node that references a database table, as reported by
+// FindWorkflowsUsingDatabase, so owners can tell which node a schema change would break.
+type DatabaseUsage struct {
+	WorkflowID int64
+	NodeID     string
+	NodeName   string
+}
+
 func (f *FieldInfo) IsRefGlobalVariable() bool {
 	if f.Source.Ref != nil && f.Source.Ref.VariableType != nil {
 		return *f.Source.Ref.VariableType == GlobalUser || *f.Source.Ref.VariableType == GlobalSystem || *f.Source.Ref.VariableType == GlobalAPP
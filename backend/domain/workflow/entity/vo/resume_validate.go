@@ -0,0 +1,144 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vo
+
+import (
+	"fmt"
+
+	"github.com/coze-dev/coze-studio/backend/pkg/sonic"
+)
+
+// resumeDataSchema is the subset of JSON Schema that interrupt events declare as InputSchema
+// (see InterruptEvent.InputSchema): enough to describe the object shape an input-receiver node
+// expects back, without pulling in a general-purpose JSON Schema validator.
+type resumeDataSchema struct {
+	Type       string                       `json:"type"`
+	Properties map[string]*resumeDataSchema `json:"properties"`
+	Required   []string                     `json:"required"`
+	Items      *resumeDataSchema            `json:"items"`
+}
+
+// ValidateResumeData checks resumeData against inputSchema, the shape an interrupt event
+// declared for its expected resume data (see InterruptEvent.InputSchema). Two shapes are
+// recognized today:
+//   - {"type":"string"[,"enum":[...]]}, produced for question/answer interrupts: resumeData is
+//     free text, so nothing further is enforced here.
+//   - anything else, produced for input-receiver interrupts: resumeData is expected to be a JSON
+//     object matching the node's declared output fields, so it's validated field-by-field against
+//     the schema's declared properties and their types.
+//
+// An empty inputSchema means no schema was derivable for this interrupt, so resumeData is left
+// unchecked, same as before this validation existed.
+func ValidateResumeData(inputSchema, resumeData string) error {
+	if inputSchema == "" {
+		return nil
+	}
+
+	var schema resumeDataSchema
+	if err := sonic.UnmarshalString(inputSchema, &schema); err != nil {
+		return nil // schema isn't one we understand; nothing to validate against
+	}
+
+	if schema.Type == "string" {
+		return nil
+	}
+
+	var v any
+	if err := sonic.UnmarshalString(resumeData, &v); err != nil {
+		return fmt.Errorf("resume data is not valid JSON matching the expected input schema: %w", err)
+	}
+
+	if err := validateAgainstSchema("", v, &schema); err != nil {
+		return fmt.Errorf("resume data does not match the expected input schema: %w", err)
+	}
+
+	return nil
+}
+
+// validateAgainstSchema checks v against schema, recursing into object properties and array
+// items. path is the dotted field path so far, used to point at where a mismatch occurred.
+func validateAgainstSchema(path string, v any, schema *resumeDataSchema) error {
+	switch schema.Type {
+	case "", "any":
+		return nil
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected an object", fieldPath(path))
+		}
+		for _, name := range schema.Required {
+			if _, ok := obj[name]; !ok {
+				return fmt.Errorf("%s: missing required field %q", fieldPath(path), name)
+			}
+		}
+		for name, fieldSchema := range schema.Properties {
+			fv, ok := obj[name]
+			if !ok {
+				continue
+			}
+			if err := validateAgainstSchema(joinFieldPath(path, name), fv, fieldSchema); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "array":
+		arr, ok := v.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected an array", fieldPath(path))
+		}
+		if schema.Items == nil {
+			return nil
+		}
+		for idx, item := range arr {
+			if err := validateAgainstSchema(fmt.Sprintf("%s[%d]", path, idx), item, schema.Items); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "string":
+		if _, ok := v.(string); !ok {
+			return fmt.Errorf("%s: expected a string", fieldPath(path))
+		}
+		return nil
+	case "integer", "number":
+		if _, ok := v.(float64); !ok {
+			return fmt.Errorf("%s: expected a number", fieldPath(path))
+		}
+		return nil
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("%s: expected a boolean", fieldPath(path))
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+func fieldPath(path string) string {
+	if path == "" {
+		return "root"
+	}
+	return path
+}
+
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
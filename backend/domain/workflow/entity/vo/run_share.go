@@ -0,0 +1,27 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vo
+
+import "time"
+
+// RunShareClaims is the payload carried by a signed run-share token (see
+// Service.CreateRunShareToken), scoping the token to read-only access to a single execution.
+type RunShareClaims struct {
+	WorkflowID int64     `json:"workflow_id"`
+	ExecuteID  int64     `json:"execute_id"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
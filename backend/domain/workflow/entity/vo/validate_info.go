@@ -20,6 +20,15 @@ type ValidateTreeConfig struct {
 	CanvasSchema string
 	AppID        *int64
 	AgentID      *int64
+	// TargetAppID and TargetSpaceID, when set, make validation check plugin/knowledge/database
+	// references against the destination app/space instead of the workflow's own, for pre-move
+	// validation of a workflow that's about to be copied or moved there.
+	TargetAppID   *int64
+	TargetSpaceID *int64
+	// AnnotateByElement, when true, makes ValidateTree additionally group the returned issues by
+	// the node ID or edge they belong to, so callers can overlay them directly onto canvas elements
+	// instead of walking a flat list.
+	AnnotateByElement bool
 }
 
 type ValidateIssue struct {
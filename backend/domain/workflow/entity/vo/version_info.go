@@ -40,4 +40,18 @@ type VersionMeta struct {
 	VersionDescription string
 	VersionCreatedAt   time.Time
 	VersionCreatorID   int64
+	Deprecated         bool
+	DeprecationMessage string
+}
+
+// ChangelogEntry describes one published version for GetWorkflowChangelog, in chronological
+// order, along with an auto-generated summary of the nodes added/removed since the previous
+// version (the first version in the history has no prior version to diff against).
+type ChangelogEntry struct {
+	Version            string
+	VersionDescription string
+	VersionCreatedAt   time.Time
+	VersionCreatorID   int64
+	NodesAdded         []string
+	NodesRemoved       []string
 }
@@ -21,4 +21,19 @@ import "github.com/coze-dev/coze-studio/backend/api/model/workflow"
 type WorkflowToolConfig struct {
 	InputParametersConfig  []*workflow.APIParameter
 	OutputParametersConfig []*workflow.APIParameter
+
+	// ResponseStyle controls how this workflow-as-tool's output is presented back to the
+	// calling LLM. Nil means fall back to the sub-workflow's own TerminatePlan.
+	ResponseStyle *workflow.ResponseStyle
 }
+
+// ResponseStyleMode mirrors workflow.ResponseStyle.Mode; named here since the thrift IDL only
+// declares the field as a bare i32.
+type ResponseStyleMode int32
+
+const (
+	// ResponseStyleModeUseLLM summarizes the tool output per the workflow's own terminate plan (default).
+	ResponseStyleModeUseLLM ResponseStyleMode = 0
+	// ResponseStyleModeSkipLLM returns the tool's raw output variables as JSON, bypassing answer-content trimming.
+	ResponseStyleModeSkipLLM ResponseStyleMode = 1
+)
@@ -49,6 +49,10 @@ func (w *Workflow) GetBasic() *WorkflowBasic {
 	}
 }
 
+func (w *Workflow) IsChatFlow() bool {
+	return w != nil && w.Meta != nil && w.Meta.Mode == workflow.WorkflowMode_ChatFlow
+}
+
 func (w *Workflow) GetLatestVersion() string {
 	if w.LatestPublishedVersion == nil {
 		return ""
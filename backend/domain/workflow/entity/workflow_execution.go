@@ -37,6 +37,11 @@ type WorkflowExecution struct {
 	NodeCount int32
 	CommitID  string
 
+	// InterruptCount is the number of times this execution has interrupted across all of its
+	// resumes so far. It is incremented each time the execution transitions into WorkflowInterrupted,
+	// and is used to guard against a buggy workflow that interrupt-resume-interrupts indefinitely.
+	InterruptCount int32
+
 	Status     WorkflowExecuteStatus
 	Duration   time.Duration
 	Input      *string
@@ -74,6 +79,16 @@ type TokenUsage struct {
 	OutputTokens int64
 }
 
+// VariantStats aggregates executions sharing the same ExperimentVariant label over a time
+// window, for A/B comparison between workflow variants.
+type VariantStats struct {
+	Variant         string
+	TotalCount      int64
+	SuccessCount    int64
+	AvgInputTokens  float64
+	AvgOutputTokens float64
+}
+
 type NodeExecution struct {
 	ID        int64
 	ExecuteID int64
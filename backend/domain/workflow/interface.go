@@ -18,6 +18,7 @@ package workflow
 
 import (
 	"context"
+	"time"
 
 	"github.com/cloudwego/eino/compose"
 
@@ -44,18 +45,111 @@ type Service interface {
 
 	QueryNodeProperties(ctx context.Context, id int64) (map[string]*vo.NodeProperty, error) // only draft
 	ValidateTree(ctx context.Context, id int64, validateConfig vo.ValidateTreeConfig) ([]*workflow.ValidateTreeInfo, error)
+	// ValidateNode validates a single node's configuration against its node type's expected
+	// schema, without needing the whole canvas. Intended for live per-field validation in the
+	// editor, as a faster alternative to ValidateTree while a node is being edited in isolation.
+	ValidateNode(ctx context.Context, nodeType entity.NodeType, nodeConfig string) ([]*workflow.ValidateErrorData, error)
+
+	// MigrateCanvasSchema forward-migrates canvasSchema to vo.CurrentSchemaVersion and returns
+	// the migrated document. It does not persist anything; the caller decides whether to save
+	// the result. changed is false, and migrated equals canvasSchema, if the canvas was already
+	// current.
+	MigrateCanvasSchema(ctx context.Context, canvasSchema string) (migrated string, changed bool, err error)
+
+	GenerateInputExample(ctx context.Context, id int64) (string, error) // only draft
+
+	// GetWorkflowOutputSchema returns a JSON Schema document describing a workflow's draft
+	// output, generated from OutputParams. If the workflow ends in an Exit node configured to
+	// return its answer content (TerminatePlan == UseAnswerContent), it returns a plain string
+	// schema instead, since that output is free-text rather than structured variables.
+	GetWorkflowOutputSchema(ctx context.Context, id int64) (string, error) // only draft
+
+	SaveDraftSnapshot(ctx context.Context, id int64) (*vo.DraftSnapshotMeta, error)
+	ListDraftSnapshots(ctx context.Context, id int64) ([]*vo.DraftSnapshotMeta, error)
+	RestoreDraftSnapshot(ctx context.Context, id int64, commitID string) error
+
+	// AcquireEditLock grants the draft-edit lock on workflowID to holderID, refreshing the TTL
+	// if holderID already holds it. It returns false, without error, if someone else holds it.
+	AcquireEditLock(ctx context.Context, workflowID, holderID int64) (bool, error)
+	ReleaseEditLock(ctx context.Context, workflowID, holderID int64) error
+	GetEditLock(ctx context.Context, workflowID int64) (*entity.EditLock, bool, error)
+
+	// SaveTestRunPreset creates or overwrites, by name, a named test-run input set for id on
+	// behalf of userID, so it can be reloaded into later test runs instead of retyping inputs.
+	SaveTestRunPreset(ctx context.Context, id, userID int64, name string, input map[string]string) error
+	ListTestRunPresets(ctx context.Context, id, userID int64) ([]*entity.TestRunPreset, error)
+	DeleteTestRunPreset(ctx context.Context, id, userID int64, name string) error
 
 	GetWorkflowReference(ctx context.Context, id int64) (map[int64]*vo.Meta, error)
 
+	// FindWorkflowsUsingPlugin scans draft workflows in the given space for ones whose nodes
+	// depend on pluginID, directly or through a sub-workflow or LLM tool call, via the same
+	// dependency data GetWorkflowDependenceResource collects. It helps operators assess the
+	// blast radius before deprecating or modifying a plugin.
+	FindWorkflowsUsingPlugin(ctx context.Context, spaceID, pluginID int64) ([]*vo.Meta, error)
+
+	// FindWorkflowsUsingKnowledge scans one page of draft workflows in the given space, via the
+	// same dependency data GetWorkflowDependenceResource collects, for ones whose nodes depend on
+	// knowledgeID, directly or through a sub-workflow or LLM tool call. page paginates the
+	// underlying scan, not the match count, since matches can't be filtered at the query level;
+	// total is the number of draft workflows in spaceID, for driving the scan to completion.
+	FindWorkflowsUsingKnowledge(ctx context.Context, spaceID, knowledgeID int64, page *vo.Page) (matches []*vo.Meta, total int64, err error)
+
+	// FindWorkflowsUsingDatabase scans draft workflows in the given space for nodes that
+	// reference databaseID, returning one entry per matching node so an owner can tell which
+	// nodes a table schema change would break.
+	FindWorkflowsUsingDatabase(ctx context.Context, spaceID, databaseID int64) ([]*vo.DatabaseUsage, error)
+
+	// FindGlobalVariableUsages scans draft workflows in the given space for nodes that
+	// reference the named global variable, so renames or deletions can warn about impact.
+	FindGlobalVariableUsages(ctx context.Context, spaceID int64, varType vo.GlobalVarType, varName string) ([]*vo.GlobalVariableUsage, error)
+	// RenameGlobalVariable renames every reference to oldName as newName across the draft
+	// workflows that use it, re-saving each affected draft, and returns the usages it updated.
+	RenameGlobalVariable(ctx context.Context, spaceID int64, varType vo.GlobalVarType, oldName, newName string) ([]*vo.GlobalVariableUsage, error)
+
 	GetWorkflowVersionsByConnector(ctx context.Context, connectorID, workflowID int64, limit int) ([]string, error)
 
+	// DeprecateWorkflowVersion flags the given published version as deprecated, optionally with a
+	// message that should be surfaced to callers that keep invoking it (e.g. via OpenAPIRun).
+	DeprecateWorkflowVersion(ctx context.Context, id int64, version string, message string) error
+	// ListWorkflowVersions returns the published version history of id, newest first, including
+	// each version's deprecation status.
+	ListWorkflowVersions(ctx context.Context, id int64) ([]*vo.VersionMeta, error)
+	// GetWorkflowChangelog returns id's published version history in chronological order, each
+	// entry carrying its description, creator and timestamp plus an auto-generated summary of the
+	// nodes added and removed since the previous version.
+	GetWorkflowChangelog(ctx context.Context, id int64) ([]*vo.ChangelogEntry, error)
+
+	// GetCachedOpenAPIResult returns the OpenAPIRun result previously cached under key, if any.
+	// Callers are expected to derive key from the workflow's published version and a normalized
+	// form of the request's input parameters.
+	GetCachedOpenAPIResult(ctx context.Context, key string) (result string, ok bool, err error)
+	// CacheOpenAPIResult caches an OpenAPIRun result under key for ttl, so a repeat call with the
+	// same version and input can be served without re-executing the workflow.
+	CacheOpenAPIResult(ctx context.Context, key, result string, ttl time.Duration) error
+
+	// CreateRunShareToken mints a signed, opaque token granting read-only access to executeID's
+	// process for ttl, so a run can be shared with someone outside the workflow's space. Callers
+	// validate it with ParseRunShareToken as an alternative to the normal space-membership check.
+	CreateRunShareToken(ctx context.Context, workflowID, executeID int64, ttl time.Duration) (string, error)
+	// ParseRunShareToken verifies token and returns the claims it was minted with, or an error if
+	// it's malformed, tampered with, or expired.
+	ParseRunShareToken(ctx context.Context, token string) (*vo.RunShareClaims, error)
+
 	Executable
 	AsTool
 
 	ReleaseApplicationWorkflows(ctx context.Context, appID int64, config *vo.ReleaseWorkflowConfig) ([]*vo.ValidateIssue, error)
 	CopyWorkflowFromAppToLibrary(ctx context.Context, workflowID int64, appID int64, related vo.ExternalResourceRelated) (*entity.CopyWorkflowFromAppToLibraryResult, error)
 	DuplicateWorkflowsByAppID(ctx context.Context, sourceAPPID, targetAppID int64, related vo.ExternalResourceRelated) ([]*entity.Workflow, error)
+	// GetWorkflowDependenceResource collects the plugins, knowledge bases and databases the given
+	// workflow depends on. It recurses through sub-workflow nodes and LLM-node workflow-as-tool
+	// references, so transitive dependencies of nested sub-workflows are included as well.
 	GetWorkflowDependenceResource(ctx context.Context, workflowID int64) (*vo.DependenceResource, error)
+	// GetWorkflowComplexity computes a governance-facing complexity score for the given
+	// workflow's draft canvas, from its node count, composite-node branching depth, sub-workflow
+	// nesting depth and external (plugin/knowledge/database) reference count.
+	GetWorkflowComplexity(ctx context.Context, workflowID int64) (*vo.WorkflowComplexity, error)
 	SyncRelatedWorkflowResources(ctx context.Context, appID int64, relatedWorkflows map[int64]entity.IDVersionPair, related vo.ExternalResourceRelated) error
 
 	ChatFlowRole
@@ -80,6 +174,11 @@ type Repository interface {
 	UpdateMeta(ctx context.Context, id int64, metaUpdate *vo.MetaUpdate) error
 	GetVersion(ctx context.Context, id int64, version string) (*vo.VersionInfo, bool, error)
 	GetVersionListByConnectorAndWorkflowID(ctx context.Context, connectorID, workflowID int64, limit int) ([]string, error)
+	DeprecateVersion(ctx context.Context, id int64, version string, message string) error
+	ListVersions(ctx context.Context, id int64) ([]*vo.VersionMeta, error)
+	// ListVersionsWithCanvas returns id's published versions in chronological (oldest first)
+	// order, including each version's canvas schema, for changelog-style diffing.
+	ListVersionsWithCanvas(ctx context.Context, id int64) ([]*vo.VersionInfo, error)
 
 	GetEntity(ctx context.Context, policy *vo.GetPolicy) (*entity.Workflow, error)
 
@@ -96,10 +195,15 @@ type Repository interface {
 	MGetLatestVersion(ctx context.Context, policy *vo.MGetPolicy) ([]*entity.Workflow, int64, error)
 
 	CreateSnapshotIfNeeded(ctx context.Context, id int64, commitID string) error
+	SaveDraftSnapshot(ctx context.Context, id int64) (*vo.DraftSnapshotMeta, error)
+	ListDraftSnapshots(ctx context.Context, id int64) ([]*vo.DraftSnapshotMeta, error)
 
 	InterruptEventStore
 	CancelSignalStore
 	ExecuteHistoryStore
+	EditLockStore
+	TestRunPresetStore
+	ResultCacheStore
 
 	WorkflowAsTool(ctx context.Context, policy vo.GetPolicy, wfToolConfig vo.WorkflowToolConfig) (ToolFromWorkflow, error)
 
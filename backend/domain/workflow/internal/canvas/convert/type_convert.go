@@ -24,6 +24,7 @@ import (
 
 	einoCompose "github.com/cloudwego/eino/compose"
 
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/schema"
 	"github.com/coze-dev/coze-studio/backend/pkg/lang/ptr"
@@ -570,6 +571,46 @@ func SetOutputsForNodeSchema(n *vo.Node, ns *schema.NodeSchema) error {
 	return nil
 }
 
+// OutputTypesForNode resolves n's declared output fields to their TypeInfo, keyed by field name.
+// It mirrors SetOutputTypesForNodeSchema/SetOutputsForNodeSchema's shape dispatch without building
+// a full NodeSchema, for callers such as reference validation that only need the type map.
+func OutputTypesForNode(n *vo.Node) (map[string]*vo.TypeInfo, error) {
+	types := make(map[string]*vo.TypeInfo, len(n.Data.Outputs))
+
+	et := entity.IDStrToNodeType(n.Type)
+	if et == entity.NodeTypeBatch || et == entity.NodeTypeLoop {
+		for _, vAny := range n.Data.Outputs {
+			param, err := ParseParam(vAny)
+			if err != nil {
+				return nil, err
+			}
+			tInfo, err := CanvasBlockInputToTypeInfo(param.Input)
+			if err != nil {
+				return nil, err
+			}
+			types[param.Name] = tInfo
+		}
+		return types, nil
+	}
+
+	for _, vAny := range n.Data.Outputs {
+		v, err := vo.ParseVariable(vAny)
+		if err != nil {
+			return nil, err
+		}
+		if v.ReadOnly && v.Name == "errorBody" { // reserved output field when exception happens
+			continue
+		}
+		tInfo, err := CanvasVariableToTypeInfo(v)
+		if err != nil {
+			return nil, err
+		}
+		types[v.Name] = tInfo
+	}
+
+	return types, nil
+}
+
 func BlockInputToNamedTypeInfo(name string, b *vo.BlockInput) (*vo.NamedTypeInfo, error) {
 	tInfo := &vo.NamedTypeInfo{
 		Name: name,
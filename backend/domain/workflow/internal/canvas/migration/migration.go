@@ -0,0 +1,92 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package migration forward-migrates a canvas schema JSON document from an older
+// vo.Canvas.SchemaVersion to vo.CurrentSchemaVersion. Canvases are migrated at the raw JSON
+// level, one version step at a time, so a legacy document can be brought forward without
+// first forcing it through the current vo.Canvas struct shape.
+package migration
+
+import (
+	"fmt"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+)
+
+// step migrates a canvas document from its version to the next one, returning the document
+// with its "schemaVersion" field advanced accordingly.
+type step func(canvas map[string]any) (map[string]any, error)
+
+// chain holds one step per version transition, indexed by the version being migrated FROM.
+// Appending support for a future schema version only requires adding an entry here.
+var chain = map[int]step{
+	0: migrateV0ToV1,
+}
+
+// migrateV0ToV1 stamps schemaVersion onto a pre-versioning canvas. No other part of the
+// document changes shape between these two versions; schemaVersion just didn't exist yet.
+func migrateV0ToV1(canvas map[string]any) (map[string]any, error) {
+	canvas["schemaVersion"] = 1
+	return canvas, nil
+}
+
+// Migrate forward-migrates canvasJSON to vo.CurrentSchemaVersion, applying every step in chain
+// in order starting from the document's current schemaVersion (missing or zero means the
+// earliest, pre-versioning format). It returns the original document unchanged, with changed
+// set to false, if the document is already at or above vo.CurrentSchemaVersion.
+func Migrate(canvasJSON string) (migrated string, changed bool, err error) {
+	var doc map[string]any
+	if err = sonic.UnmarshalString(canvasJSON, &doc); err != nil {
+		return "", false, fmt.Errorf("failed to unmarshal canvas for migration: %w", err)
+	}
+
+	version := schemaVersion(doc)
+	if version >= vo.CurrentSchemaVersion {
+		return canvasJSON, false, nil
+	}
+
+	for version < vo.CurrentSchemaVersion {
+		s, ok := chain[version]
+		if !ok {
+			return "", false, fmt.Errorf("no migration step registered for canvas schema version %d", version)
+		}
+		if doc, err = s(doc); err != nil {
+			return "", false, fmt.Errorf("failed to migrate canvas from schema version %d: %w", version, err)
+		}
+		version = schemaVersion(doc)
+	}
+
+	out, err := sonic.MarshalString(doc)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to marshal migrated canvas: %w", err)
+	}
+
+	return out, true, nil
+}
+
+func schemaVersion(doc map[string]any) int {
+	v, ok := doc["schemaVersion"]
+	if !ok {
+		return 0
+	}
+	f, ok := v.(float64)
+	if !ok {
+		return 0
+	}
+	return int(f)
+}
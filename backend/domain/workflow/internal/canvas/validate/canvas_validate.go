@@ -22,6 +22,7 @@ import (
 
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/coze-dev/coze-studio/backend/domain/workflow"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
@@ -39,6 +40,16 @@ type Issue struct {
 	NodeErr *NodeErr
 	PathErr *PathErr
 	Message string
+	// Code is a stable identifier for the kind of problem Message describes, used to look up a
+	// localized message via LocalizedMessage. It is empty for issues that don't go through the
+	// catalog, in which case Message (English only) is used as-is.
+	Code IssueCode
+	// Args are formatted into the catalog template for Code, in the same order the template's
+	// verbs expect. Unused when Code is empty.
+	Args []any
+	// IsWarning marks an issue as informational only: it is surfaced to the caller but does not
+	// block the validation from succeeding, e.g. a deprecated node being used in the canvas.
+	IsWarning bool
 }
 type NodeErr struct {
 	NodeID   string `json:"nodeID"`
@@ -59,6 +70,11 @@ type Config struct {
 	AppID               *int64
 	AgentID             *int64
 	VariablesMetaGetter variable.VariablesMetaGetter
+	// TargetAppID and TargetSpaceID, when set, make CheckExternalResourceReferences look up
+	// plugin/knowledge/database references in this app/space instead of skipping the check, for
+	// pre-move validation of a workflow that's about to be copied or moved there.
+	TargetAppID   *int64
+	TargetSpaceID *int64
 }
 
 type CanvasValidator struct {
@@ -112,6 +128,7 @@ func (cv *CanvasValidator) DetectCycles(_ context.Context) (issues []*Issue, err
 					EndNode:   cycle[(i+1)%n],
 				},
 				Message: "line connections do not allow parallel lines to intersect and form loops with each other",
+				Code:    IssueCodeCycleDetected,
 			})
 		}
 	}
@@ -128,6 +145,109 @@ func (cv *CanvasValidator) ValidateConnections(ctx context.Context) (issues []*I
 	return issues, nil
 }
 
+// CheckEndNodeReachability flags an end node that the start node has no path to, and any other
+// node that has no path onward to an end node, using the same control-edges vo.Canvas already
+// carries. Both are checked per composite node (batch/loop) as well as at the top level, since
+// each has its own synthetic start/end pair.
+func (cv *CanvasValidator) CheckEndNodeReachability(_ context.Context) (issues []*Issue, err error) {
+	issues = make([]*Issue, 0)
+
+	var walk func(c *vo.Canvas) error
+	walk = func(c *vo.Canvas) error {
+		nodeMap := buildNodeMap(c)
+		startNode, endNode, err := findStartAndEndNodes(c.Nodes)
+		if err != nil {
+			return err
+		}
+
+		forwardEdges := make(map[string][]string)
+		backwardEdges := make(map[string][]string)
+		for _, e := range c.Edges {
+			forwardEdges[e.SourceNodeID] = append(forwardEdges[e.SourceNodeID], e.TargetNodeID)
+			backwardEdges[e.TargetNodeID] = append(backwardEdges[e.TargetNodeID], e.SourceNodeID)
+		}
+
+		reachableFromStart := bfsReachable(forwardEdges, startNode.ID)
+		canReachEnd := bfsReachable(backwardEdges, endNode.ID)
+
+		if !reachableFromStart[endNode.ID] {
+			issues = append(issues, &Issue{
+				NodeErr: &NodeErr{NodeID: endNode.ID, NodeName: nodeTitle(endNode)},
+				Message: fmt.Sprintf(`node "%v" is not reachable from the start node`, nodeTitle(endNode)),
+				Code:    IssueCodeEndNodeUnreachable,
+				Args:    []any{nodeTitle(endNode)},
+			})
+		}
+
+		for nodeID := range reachableFromStart {
+			if nodeID == endNode.ID || canReachEnd[nodeID] {
+				continue
+			}
+			node := nodeMap[nodeID]
+			issues = append(issues, &Issue{
+				NodeErr: &NodeErr{NodeID: nodeID, NodeName: nodeTitle(node)},
+				Message: fmt.Sprintf(`node "%v" has no path to an end node`, nodeTitle(node)),
+				Code:    IssueCodeNodeHasNoPathToEnd,
+				Args:    []any{nodeTitle(node)},
+			})
+		}
+
+		for _, node := range c.Nodes {
+			if len(node.Blocks) > 0 && len(node.Edges) > 0 {
+				nestedCanvas := &vo.Canvas{
+					Nodes: append([]*vo.Node{
+						{ID: node.ID, Type: entity.NodeTypeEntry.IDStr(), Data: node.Data},
+						{ID: node.ID, Type: entity.NodeTypeExit.IDStr()},
+					}, node.Blocks...),
+					Edges: node.Edges,
+				}
+				if err := walk(nestedCanvas); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if err = walk(cv.cfg.Canvas); err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
+// bfsReachable returns the set of node IDs reachable from start by following adj, including
+// start itself.
+func bfsReachable(adj map[string][]string, start string) map[string]bool {
+	visited := map[string]bool{start: true}
+	queue := []string{start}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, next := range adj[cur] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return visited
+}
+
+// nodeTitle returns node's frontend-facing title, falling back to its ID for synthetic nodes
+// (e.g. the entry/exit pair processNestedReachability builds for a composite node) that carry no
+// Data.
+func nodeTitle(node *vo.Node) string {
+	if node == nil {
+		return ""
+	}
+	if node.Data != nil && node.Data.Meta != nil && node.Data.Meta.Title != "" {
+		return node.Data.Meta.Title
+	}
+	return node.ID
+}
+
 func (cv *CanvasValidator) CheckRefVariable(_ context.Context) (issues []*Issue, err error) {
 	issues = make([]*Issue, 0)
 	var checkRefVariable func(reachability *reachability, reachableNodes map[string]bool) error
@@ -166,6 +286,7 @@ func (cv *CanvasValidator) CheckRefVariable(_ context.Context) (issues []*Issue,
 						NodeName: node.Data.Meta.Title,
 					},
 					Message: `ref block error,[blockID] is empty`,
+					Code:    IssueCodeRefBlockEmpty,
 				})
 				return nil
 			}
@@ -177,6 +298,8 @@ func (cv *CanvasValidator) CheckRefVariable(_ context.Context) (issues []*Issue,
 						NodeName: node.Data.Meta.Title,
 					},
 					Message: fmt.Sprintf(`the node id "%s" on which node id "%s" depends does not exist`, node.ID, ref.BlockID),
+					Code:    IssueCodeRefNodeMissing,
+					Args:    []any{node.ID, ref.BlockID},
 				})
 			}
 			return nil
@@ -195,6 +318,8 @@ func (cv *CanvasValidator) CheckRefVariable(_ context.Context) (issues []*Issue,
 									NodeName: node.Data.Meta.Title,
 								},
 								Message: fmt.Sprintf(`parameter name only allows number or alphabet, and must begin with alphabet, but it's "%s"`, p.Name),
+								Code:    IssueCodeInvalidParameterName,
+								Args:    []any{p.Name},
 							})
 						}
 						err = inputBlockVerify(node, p.Input)
@@ -242,6 +367,139 @@ func (cv *CanvasValidator) CheckRefVariable(_ context.Context) (issues []*Issue,
 	return issues, nil
 }
 
+// CheckInputReferenceTypes checks, for every input parameter that references an upstream node's
+// output, that the referenced field actually exists on that node and that its type matches the
+// type the referencing parameter was configured with. It assumes the references themselves (block
+// existence, blockID non-empty) were already validated by CheckRefVariable, and skips whatever that
+// check already flagged as broken.
+func (cv *CanvasValidator) CheckInputReferenceTypes(_ context.Context) (issues []*Issue, err error) {
+	issues = make([]*Issue, 0)
+	outputTypes := make(map[string]map[string]*vo.TypeInfo)
+
+	var check func(reachability *reachability, parentNodes map[string]*vo.Node) error
+	check = func(reachability *reachability, parentNodes map[string]*vo.Node) error {
+		combinedNodes := make(map[string]*vo.Node, len(reachability.reachableNodes)+len(parentNodes))
+		for id, node := range parentNodes {
+			combinedNodes[id] = node
+		}
+		for id, node := range reachability.reachableNodes {
+			combinedNodes[id] = node
+		}
+
+		checkBlockInput := func(node *vo.Node, inputBlock *vo.BlockInput) error {
+			if inputBlock == nil || inputBlock.Value == nil || inputBlock.Value.Type != vo.BlockInputValueTypeRef {
+				return nil
+			}
+			ref, err := parseBlockInputRef(inputBlock.Value.Content)
+			if err != nil {
+				return err
+			}
+			if ref.Source != vo.RefSourceTypeBlockOutput || ref.Name == "" {
+				return nil // global variable refs are checked by CheckGlobalVariables; an empty Name is an all-to-all mapping
+			}
+
+			refNode, ok := combinedNodes[ref.BlockID]
+			if !ok {
+				return nil // already reported by CheckRefVariable
+			}
+
+			types, ok := outputTypes[ref.BlockID]
+			if !ok {
+				types, err = convert.OutputTypesForNode(refNode)
+				if err != nil {
+					return err
+				}
+				outputTypes[ref.BlockID] = types
+			}
+
+			path := strings.Split(ref.Name, ".")
+			refType, ok := resolveTypeInfoByPath(types, path)
+			if !ok {
+				issues = append(issues, &Issue{
+					NodeErr: &NodeErr{NodeID: node.ID, NodeName: nodeTitle(node)},
+					Message: fmt.Sprintf(`node "%v" references field "%v" on node "%v", but it has no such output`,
+						nodeTitle(node), ref.Name, nodeTitle(refNode)),
+					Code: IssueCodeRefFieldNotFound,
+					Args: []any{nodeTitle(node), ref.Name, nodeTitle(refNode)},
+				})
+				return nil
+			}
+
+			inType, err := convert.CanvasBlockInputToTypeInfo(inputBlock)
+			if err != nil {
+				return err
+			}
+			if inType.Type != "" && inType.Type != refType.Type {
+				issues = append(issues, &Issue{
+					NodeErr: &NodeErr{NodeID: node.ID, NodeName: nodeTitle(node)},
+					Message: fmt.Sprintf(`node "%v"'s reference to field "%v" on node "%v" has a type mismatch`,
+						nodeTitle(node), ref.Name, nodeTitle(refNode)),
+					Code: IssueCodeRefFieldTypeMismatch,
+					Args: []any{nodeTitle(node), ref.Name, nodeTitle(refNode)},
+				})
+			}
+
+			return nil
+		}
+
+		for _, node := range reachability.reachableNodes {
+			if node.Data == nil || node.Data.Inputs == nil || node.Data.Inputs.InputParameters == nil {
+				continue
+			}
+			for _, p := range node.Data.Inputs.InputParameters {
+				if err := checkBlockInput(node, p.Input); err != nil {
+					return err
+				}
+				if err := checkBlockInput(node, p.Left); err != nil {
+					return err
+				}
+				if err := checkBlockInput(node, p.Right); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, r := range reachability.nestedReachability {
+			if err := check(r, combinedNodes); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err = check(cv.reachability, nil); err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
+// resolveTypeInfoByPath looks up path[0] in types, then descends into Properties for the remaining
+// path segments. It reports ok=false as soon as any segment along the way is missing.
+func resolveTypeInfoByPath(types map[string]*vo.TypeInfo, path []string) (info *vo.TypeInfo, ok bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+
+	info, ok = types[path[0]]
+	if !ok {
+		return nil, false
+	}
+
+	for _, segment := range path[1:] {
+		if info.Properties == nil {
+			return nil, false
+		}
+		info, ok = info.Properties[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return info, true
+}
+
 func (cv *CanvasValidator) ValidateNestedFlows(_ context.Context) (issues []*Issue, err error) {
 	issues = make([]*Issue, 0)
 	for nodeID, node := range cv.reachability.reachableNodes {
@@ -252,6 +510,7 @@ func (cv *CanvasValidator) ValidateNestedFlows(_ context.Context) (issues []*Iss
 					NodeName: node.Data.Meta.Title,
 				},
 				Message: "composite nodes such as batch/loop cannot be nested",
+				Code:    IssueCodeNestedCompositeNotAllowed,
 			})
 		}
 	}
@@ -302,6 +561,15 @@ func (cv *CanvasValidator) CheckGlobalVariables(ctx context.Context) (issues []*
 		for v, info := range nodeVar.vars {
 			vInfo, ok := varsMeta[v]
 			if !ok {
+				issues = append(issues, &Issue{
+					NodeErr: &NodeErr{
+						NodeID:   nodeID,
+						NodeName: nodeName,
+					},
+					Message: fmt.Sprintf("node name %v, param [%s], global variable not found", nodeName, v),
+					Code:    IssueCodeGlobalVariableNotFound,
+					Args:    []any{nodeName, v},
+				})
 				continue
 			}
 
@@ -312,6 +580,8 @@ func (cv *CanvasValidator) CheckGlobalVariables(ctx context.Context) (issues []*
 						NodeName: nodeName,
 					},
 					Message: fmt.Sprintf("node name %v,param [%s], type mismatch", nodeName, v),
+					Code:    IssueCodeGlobalVariableTypeMismatch,
+					Args:    []any{nodeName, v},
 				})
 			}
 
@@ -323,6 +593,8 @@ func (cv *CanvasValidator) CheckGlobalVariables(ctx context.Context) (issues []*
 							NodeName: nodeName,
 						},
 						Message: fmt.Sprintf("node name %v, param [%s], array element type mismatch", nodeName, v),
+						Code:    IssueCodeGlobalVariableArrayElemMismatch,
+						Args:    []any{nodeName, v},
 					})
 
 				}
@@ -421,6 +693,7 @@ func (cv *CanvasValidator) CheckSubWorkFlowTerminatePlanType(ctx context.Context
 					NodeName: node.Data.Meta.Title,
 				},
 				Message: "sub workflow has been modified, please refresh the page",
+				Code:    IssueCodeSubWorkflowModified,
 			})
 		} else {
 			_, endNode, err := findStartAndEndNodes(c.Nodes)
@@ -527,24 +800,25 @@ func validateConnections(ctx context.Context, c *vo.Canvas) (issues []*Issue, er
 						NodeName: nodeName,
 					},
 					Message: `node "start" not connected`,
+					Code:    IssueCodeStartNodeNotConnected,
 				})
 			}
 		case entity.NodeTypeExit:
 		default:
 			if ports, isSelector := selectorPorts[nodeID]; isSelector {
-				message := ""
 				for port := range ports {
 					if portOutDegree[nodeID][port] == 0 {
-						message += fmt.Sprintf(`node "%v"'s port "%v" not connected;`, nodeName, port)
+						issues = append(issues, &Issue{
+							NodeErr: &NodeErr{
+								NodeID:   node.ID,
+								NodeName: nodeName,
+							},
+							Message: fmt.Sprintf(`node "%v"'s port "%v" not connected`, nodeName, port),
+							Code:    IssueCodePortNotConnected,
+							Args:    []any{nodeName, port},
+						})
 					}
 				}
-				if len(message) > 0 {
-					selectorIssues := &Issue{NodeErr: &NodeErr{
-						NodeID:   node.ID,
-						NodeName: nodeName,
-					}, Message: message}
-					issues = append(issues, selectorIssues)
-				}
 			} else {
 				// Break, continue without checking out degrees
 				if et == entity.NodeTypeBreak || et == entity.NodeTypeContinue {
@@ -557,6 +831,8 @@ func validateConnections(ctx context.Context, c *vo.Canvas) (issues []*Issue, er
 							NodeName: nodeName,
 						},
 						Message: fmt.Sprintf(`node "%v" not connected`, nodeName),
+						Code:    IssueCodeNodeNotConnected,
+						Args:    []any{nodeName},
 					})
 				}
 			}
@@ -752,3 +1028,103 @@ var validateNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
 func validateInputParameterName(name string) bool {
 	return validateNameRegex.Match([]byte(name))
 }
+
+// ValidateNode validates a single node's configuration against its node type's expected schema,
+// without requiring the whole canvas. This is much cheaper than NewCanvasValidator plus the full
+// suite of Validate* checks, and supports live per-field validation while a node is being edited,
+// before it is wired into any graph.
+func ValidateNode(ctx context.Context, nodeType entity.NodeType, nodeConfig string) (issues []*Issue, err error) {
+	issues = make([]*Issue, 0)
+
+	meta := entity.NodeMetaByNodeType(nodeType)
+	if meta == nil {
+		issues = append(issues, &Issue{
+			Message: fmt.Sprintf(`unknown node type "%s"`, nodeType),
+			Code:    IssueCodeUnknownNodeType,
+			Args:    []any{nodeType},
+		})
+		return issues, nil
+	}
+
+	data := &vo.Data{}
+	if err := sonic.UnmarshalString(nodeConfig, data); err != nil {
+		return nil, vo.WrapError(errno.ErrSerializationDeserializationFail,
+			fmt.Errorf("failed to unmarshal node config: %w", err))
+	}
+
+	if data.Inputs != nil {
+		for _, p := range data.Inputs.InputParameters {
+			if !validateInputParameterName(p.Name) {
+				issues = append(issues, &Issue{
+					Message: fmt.Sprintf(`parameter name only allows number or alphabet, and must begin with alphabet, but it's "%s"`, p.Name),
+					Code:    IssueCodeInvalidParameterName,
+					Args:    []any{p.Name},
+				})
+			}
+		}
+	}
+
+	n := &vo.Node{ID: "node_under_validation", Type: nodeType.IDStr(), Data: data}
+
+	if adaptErr := adaptNode(ctx, nodeType, n); adaptErr != nil {
+		issues = append(issues, &Issue{Message: adaptErr.Error()})
+	}
+
+	return issues, nil
+}
+
+// adaptNode runs the node type's own NodeAdaptor against n, recovering from a panic if the node
+// type has no registered adaptor, which GetNodeAdaptor reports that way rather than returning ok.
+func adaptNode(ctx context.Context, nodeType entity.NodeType, n *vo.Node) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("node type %q cannot be validated standalone: %v", nodeType, r)
+		}
+	}()
+
+	na, _ := nodes.GetNodeAdaptor(nodeType)
+	_, err = na.Adapt(ctx, n)
+	return err
+}
+
+// CheckDeprecatedNodes warns about, but does not block on, the use of node types that have been
+// marked deprecated in entity.NodeTypeMetas, so the canvas can still be saved while the frontend
+// grays out or flags the offending nodes.
+func (cv *CanvasValidator) CheckDeprecatedNodes(_ context.Context) (issues []*Issue, err error) {
+	issues = make([]*Issue, 0)
+
+	var walk func(nodes []*vo.Node)
+	walk = func(nodes []*vo.Node) {
+		for _, node := range nodes {
+			nodeType := entity.IDStrToNodeType(node.Type)
+			if meta := entity.NodeMetaByNodeType(nodeType); meta != nil && meta.Deprecated {
+				msg := fmt.Sprintf(`node "%s" uses deprecated node type "%s"`, node.ID, nodeType)
+				issue := &Issue{
+					NodeErr: &NodeErr{
+						NodeID:   node.ID,
+						NodeName: node.Data.Meta.Title,
+					},
+					IsWarning: true,
+				}
+				if len(meta.ReplacedBy) > 0 {
+					// The replacement suggestion is specific to this canvas's deprecation metadata,
+					// so it isn't part of the catalog template; fall back to the raw message.
+					msg += fmt.Sprintf(`, consider replacing it with "%s"`, meta.ReplacedBy)
+					issue.Message = msg
+				} else {
+					issue.Message = msg
+					issue.Code = IssueCodeDeprecatedNodeUsed
+					issue.Args = []any{node.ID, string(nodeType)}
+				}
+				issues = append(issues, issue)
+			}
+			if len(node.Blocks) > 0 {
+				walk(node.Blocks)
+			}
+		}
+	}
+
+	walk(cv.cfg.Canvas.Nodes)
+
+	return issues, nil
+}
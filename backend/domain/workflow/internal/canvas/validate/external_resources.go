@@ -0,0 +1,220 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validate
+
+import (
+	"context"
+	"strconv"
+
+	crossdatabase "github.com/coze-dev/coze-studio/backend/crossdomain/database"
+	databaseModel "github.com/coze-dev/coze-studio/backend/crossdomain/database/model"
+	crossknowledge "github.com/coze-dev/coze-studio/backend/crossdomain/knowledge"
+	knowledgeModel "github.com/coze-dev/coze-studio/backend/crossdomain/knowledge/model"
+	crossplugin "github.com/coze-dev/coze-studio/backend/crossdomain/plugin"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+	"github.com/coze-dev/coze-studio/backend/pkg/lang/slices"
+)
+
+type externalResourceRef struct {
+	nodeID   string
+	nodeName string
+	id       int64
+}
+
+// CheckExternalResourceReferences checks that the plugins, knowledge bases and databases the
+// canvas's nodes reference still exist in cv.cfg.TargetAppID/TargetSpaceID, for validating a
+// workflow ahead of copying or moving it to that app/space. It is a no-op, returning no issues,
+// when neither target is set, which is the case for ordinary in-place validation.
+func (cv *CanvasValidator) CheckExternalResourceReferences(ctx context.Context) (issues []*Issue, err error) {
+	issues = make([]*Issue, 0)
+	if cv.cfg.TargetAppID == nil && cv.cfg.TargetSpaceID == nil {
+		return issues, nil
+	}
+
+	var pluginRefs, knowledgeRefs, databaseRefs []externalResourceRef
+
+	var walk func(nodes []*vo.Node)
+	walk = func(nodes []*vo.Node) {
+		for _, node := range nodes {
+			meta := entity.NodeMetaByNodeType(entity.IDStrToNodeType(node.Type))
+			if meta != nil && node.Data != nil && node.Data.Inputs != nil {
+				nodeName := node.ID
+				if node.Data.Meta != nil && node.Data.Meta.Title != "" {
+					nodeName = node.Data.Meta.Title
+				}
+
+				switch {
+				case meta.UsePlugin:
+					apiParams := slices.ToMap(node.Data.Inputs.APIParams, func(e *vo.Param) (string, *vo.Param) {
+						return e.Name, e
+					})
+					if p, ok := apiParams["pluginID"]; ok {
+						if s, ok := p.Input.Value.Content.(string); ok {
+							if pluginID, convErr := strconv.ParseInt(s, 10, 64); convErr == nil {
+								pluginRefs = append(pluginRefs, externalResourceRef{node.ID, nodeName, pluginID})
+							}
+						}
+					}
+				case meta.UseKnowledge:
+					if len(node.Data.Inputs.DatasetParam) > 0 && node.Data.Inputs.DatasetParam[0].Input != nil {
+						if ids, ok := node.Data.Inputs.DatasetParam[0].Input.Value.Content.([]any); ok {
+							for _, id := range ids {
+								if s, ok := id.(string); ok {
+									if knowledgeID, convErr := strconv.ParseInt(s, 10, 64); convErr == nil {
+										knowledgeRefs = append(knowledgeRefs, externalResourceRef{node.ID, nodeName, knowledgeID})
+									}
+								}
+							}
+						}
+					}
+				case meta.UseDatabase:
+					for _, d := range node.Data.Inputs.DatabaseInfoList {
+						if databaseID, convErr := strconv.ParseInt(d.DatabaseInfoID, 10, 64); convErr == nil {
+							databaseRefs = append(databaseRefs, externalResourceRef{node.ID, nodeName, databaseID})
+						}
+					}
+				}
+			}
+			if len(node.Blocks) > 0 {
+				walk(node.Blocks)
+			}
+		}
+	}
+	walk(cv.cfg.Canvas.Nodes)
+
+	if pluginIssues, err := cv.checkPluginReferences(ctx, pluginRefs); err != nil {
+		return nil, err
+	} else {
+		issues = append(issues, pluginIssues...)
+	}
+
+	if knowledgeIssues, err := cv.checkKnowledgeReferences(ctx, knowledgeRefs); err != nil {
+		return nil, err
+	} else {
+		issues = append(issues, knowledgeIssues...)
+	}
+
+	if databaseIssues, err := cv.checkDatabaseReferences(ctx, databaseRefs); err != nil {
+		return nil, err
+	} else {
+		issues = append(issues, databaseIssues...)
+	}
+
+	return issues, nil
+}
+
+func (cv *CanvasValidator) checkPluginReferences(ctx context.Context, refs []externalResourceRef) ([]*Issue, error) {
+	if len(refs) == 0 || cv.cfg.TargetAppID == nil {
+		return nil, nil
+	}
+
+	plugins, err := crossplugin.DefaultSVC().GetAPPAllPlugins(ctx, *cv.cfg.TargetAppID)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[int64]bool, len(plugins))
+	for _, p := range plugins {
+		available[p.ID] = true
+	}
+
+	issues := make([]*Issue, 0)
+	for _, ref := range refs {
+		if available[ref.id] {
+			continue
+		}
+		issues = append(issues, &Issue{
+			NodeErr: &NodeErr{NodeID: ref.nodeID, NodeName: ref.nodeName},
+			Message: "plugin referenced by node was not found in the target app",
+			Code:    IssueCodePluginNotFoundInTarget,
+			Args:    []any{ref.id, ref.nodeName},
+		})
+	}
+	return issues, nil
+}
+
+func (cv *CanvasValidator) checkKnowledgeReferences(ctx context.Context, refs []externalResourceRef) ([]*Issue, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.id)
+	}
+
+	resp, err := crossknowledge.DefaultSVC().ListKnowledge(ctx, &knowledgeModel.ListKnowledgeRequest{
+		IDs:     ids,
+		SpaceID: cv.cfg.TargetSpaceID,
+		AppID:   cv.cfg.TargetAppID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[int64]bool, len(resp.KnowledgeList))
+	for _, k := range resp.KnowledgeList {
+		available[k.ID] = true
+	}
+
+	issues := make([]*Issue, 0)
+	for _, ref := range refs {
+		if available[ref.id] {
+			continue
+		}
+		issues = append(issues, &Issue{
+			NodeErr: &NodeErr{NodeID: ref.nodeID, NodeName: ref.nodeName},
+			Message: "knowledge base referenced by node was not found in the target app/space",
+			Code:    IssueCodeKnowledgeNotFoundInTarget,
+			Args:    []any{ref.id, ref.nodeName},
+		})
+	}
+	return issues, nil
+}
+
+func (cv *CanvasValidator) checkDatabaseReferences(ctx context.Context, refs []externalResourceRef) ([]*Issue, error) {
+	if len(refs) == 0 || cv.cfg.TargetAppID == nil {
+		return nil, nil
+	}
+
+	resp, err := crossdatabase.DefaultSVC().GetAllDatabaseByAppID(ctx, &databaseModel.GetAllDatabaseByAppIDRequest{
+		AppID: *cv.cfg.TargetAppID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[int64]bool, len(resp.Databases))
+	for _, d := range resp.Databases {
+		available[d.ID] = true
+	}
+
+	issues := make([]*Issue, 0)
+	for _, ref := range refs {
+		if available[ref.id] {
+			continue
+		}
+		issues = append(issues, &Issue{
+			NodeErr: &NodeErr{NodeID: ref.nodeID, NodeName: ref.nodeName},
+			Message: "database referenced by node was not found in the target app",
+			Code:    IssueCodeDatabaseNotFoundInTarget,
+			Args:    []any{ref.id, ref.nodeName},
+		})
+	}
+	return issues, nil
+}
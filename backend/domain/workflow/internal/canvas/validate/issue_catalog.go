@@ -0,0 +1,167 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coze-dev/coze-studio/backend/pkg/i18n"
+)
+
+// IssueCode is a stable, machine-readable identifier for an Issue, so callers can branch on the
+// kind of problem found without parsing the localized Message text.
+type IssueCode string
+
+const (
+	IssueCodeCycleDetected                   IssueCode = "cycle_detected"
+	IssueCodeRefBlockEmpty                   IssueCode = "ref_block_empty"
+	IssueCodeRefNodeMissing                  IssueCode = "ref_node_missing"
+	IssueCodeInvalidParameterName            IssueCode = "invalid_parameter_name"
+	IssueCodeNestedCompositeNotAllowed       IssueCode = "nested_composite_not_allowed"
+	IssueCodeGlobalVariableNotFound          IssueCode = "global_variable_not_found"
+	IssueCodeGlobalVariableTypeMismatch      IssueCode = "global_variable_type_mismatch"
+	IssueCodeGlobalVariableArrayElemMismatch IssueCode = "global_variable_array_elem_mismatch"
+	IssueCodeSubWorkflowModified             IssueCode = "sub_workflow_modified"
+	IssueCodeStartNodeNotConnected           IssueCode = "start_node_not_connected"
+	IssueCodePortNotConnected                IssueCode = "port_not_connected"
+	IssueCodeNodeNotConnected                IssueCode = "node_not_connected"
+	IssueCodeUnknownNodeType                 IssueCode = "unknown_node_type"
+	IssueCodeDeprecatedNodeUsed              IssueCode = "deprecated_node_used"
+	IssueCodePluginNotFoundInTarget          IssueCode = "plugin_not_found_in_target"
+	IssueCodeKnowledgeNotFoundInTarget       IssueCode = "knowledge_not_found_in_target"
+	IssueCodeDatabaseNotFoundInTarget        IssueCode = "database_not_found_in_target"
+	IssueCodeEndNodeUnreachable              IssueCode = "end_node_unreachable"
+	IssueCodeNodeHasNoPathToEnd              IssueCode = "node_has_no_path_to_end"
+	IssueCodeRefFieldNotFound                IssueCode = "ref_field_not_found"
+	IssueCodeRefFieldTypeMismatch            IssueCode = "ref_field_type_mismatch"
+)
+
+// messageCatalog holds the localized message template for each IssueCode, one per supported
+// locale. Templates are passed through fmt.Sprintf with Issue.Args, so verbs must line up with
+// the order Args is populated at the construction site.
+var messageCatalog = map[IssueCode]map[i18n.Locale]string{
+	IssueCodeCycleDetected: {
+		i18n.LocaleEN: "line connections do not allow parallel lines to intersect and form loops with each other",
+		i18n.LocaleZH: "连线不允许平行线相交形成循环",
+	},
+	IssueCodeRefBlockEmpty: {
+		i18n.LocaleEN: "ref block error, [blockID] is empty",
+		i18n.LocaleZH: "引用节点出错，[blockID] 为空",
+	},
+	IssueCodeRefNodeMissing: {
+		i18n.LocaleEN: `the node id "%s" on which node id "%s" depends does not exist`,
+		i18n.LocaleZH: `节点 "%s" 所依赖的节点 "%s" 不存在`,
+	},
+	IssueCodeInvalidParameterName: {
+		i18n.LocaleEN: `parameter name only allows number or alphabet, and must begin with alphabet, but it's "%s"`,
+		i18n.LocaleZH: `参数名只允许数字或字母，且必须以字母开头，但实际为 "%s"`,
+	},
+	IssueCodeNestedCompositeNotAllowed: {
+		i18n.LocaleEN: "composite nodes such as batch/loop cannot be nested",
+		i18n.LocaleZH: "批处理/循环等复合节点不能嵌套",
+	},
+	IssueCodeGlobalVariableNotFound: {
+		i18n.LocaleEN: "node name %v, param [%s], global variable not found",
+		i18n.LocaleZH: "节点 %v，参数 [%s]，未找到全局变量",
+	},
+	IssueCodeGlobalVariableTypeMismatch: {
+		i18n.LocaleEN: "node name %v, param [%s], type mismatch",
+		i18n.LocaleZH: "节点 %v，参数 [%s]，类型不匹配",
+	},
+	IssueCodeGlobalVariableArrayElemMismatch: {
+		i18n.LocaleEN: "node name %v, param [%s], array element type mismatch",
+		i18n.LocaleZH: "节点 %v，参数 [%s]，数组元素类型不匹配",
+	},
+	IssueCodeSubWorkflowModified: {
+		i18n.LocaleEN: "sub workflow has been modified, please refresh the page",
+		i18n.LocaleZH: "子流程已被修改，请刷新页面",
+	},
+	IssueCodeStartNodeNotConnected: {
+		i18n.LocaleEN: `node "start" not connected`,
+		i18n.LocaleZH: `"开始" 节点未连接`,
+	},
+	IssueCodePortNotConnected: {
+		i18n.LocaleEN: `node "%v"'s port "%v" not connected`,
+		i18n.LocaleZH: `节点 "%v" 的端口 "%v" 未连接`,
+	},
+	IssueCodeNodeNotConnected: {
+		i18n.LocaleEN: `node "%v" not connected`,
+		i18n.LocaleZH: `节点 "%v" 未连接`,
+	},
+	IssueCodeUnknownNodeType: {
+		i18n.LocaleEN: `unknown node type "%s"`,
+		i18n.LocaleZH: `未知节点类型 "%s"`,
+	},
+	IssueCodeDeprecatedNodeUsed: {
+		i18n.LocaleEN: `node "%s" uses deprecated node type "%s"`,
+		i18n.LocaleZH: `节点 "%s" 使用了已废弃的节点类型 "%s"`,
+	},
+	IssueCodePluginNotFoundInTarget: {
+		i18n.LocaleEN: `plugin "%v" referenced by node "%v" was not found in the target app`,
+		i18n.LocaleZH: `节点 "%v" 引用的插件 "%v" 在目标应用中不存在`,
+	},
+	IssueCodeKnowledgeNotFoundInTarget: {
+		i18n.LocaleEN: `knowledge base "%v" referenced by node "%v" was not found in the target app/space`,
+		i18n.LocaleZH: `节点 "%v" 引用的知识库 "%v" 在目标应用/空间中不存在`,
+	},
+	IssueCodeDatabaseNotFoundInTarget: {
+		i18n.LocaleEN: `database "%v" referenced by node "%v" was not found in the target app`,
+		i18n.LocaleZH: `节点 "%v" 引用的数据库 "%v" 在目标应用中不存在`,
+	},
+	IssueCodeEndNodeUnreachable: {
+		i18n.LocaleEN: `node "%v" is not reachable from the start node`,
+		i18n.LocaleZH: `节点 "%v" 无法从开始节点到达`,
+	},
+	IssueCodeNodeHasNoPathToEnd: {
+		i18n.LocaleEN: `node "%v" has no path to an end node`,
+		i18n.LocaleZH: `节点 "%v" 没有到达结束节点的路径`,
+	},
+	IssueCodeRefFieldNotFound: {
+		i18n.LocaleEN: `node "%v" references field "%v" on node "%v", but it has no such output`,
+		i18n.LocaleZH: `节点 "%v" 引用的字段 "%v" 在节点 "%v" 上不存在`,
+	},
+	IssueCodeRefFieldTypeMismatch: {
+		i18n.LocaleEN: `node "%v"'s reference to field "%v" on node "%v" has a type mismatch`,
+		i18n.LocaleZH: `节点 "%v" 引用的字段 "%v"（位于节点 "%v"）类型不匹配`,
+	},
+}
+
+// LocalizedMessage returns iss.Message translated via messageCatalog for the locale in ctx, when
+// iss.Code has a catalog entry. Issues without a registered code (e.g. ones wrapping an arbitrary
+// adaptor error) fall back to the English Message they were constructed with.
+func (iss *Issue) LocalizedMessage(ctx context.Context) string {
+	if iss.Code == "" {
+		return iss.Message
+	}
+
+	locales, ok := messageCatalog[iss.Code]
+	if !ok {
+		return iss.Message
+	}
+
+	tpl, ok := locales[i18n.GetLocale(ctx)]
+	if !ok {
+		tpl = locales[i18n.LocaleEN]
+	}
+
+	if len(iss.Args) == 0 {
+		return tpl
+	}
+
+	return fmt.Sprintf(tpl, iss.Args...)
+}
@@ -29,6 +29,8 @@ import (
 	"github.com/cloudwego/eino/schema"
 	"golang.org/x/exp/maps"
 
+	model "github.com/coze-dev/coze-studio/backend/crossdomain/workflow/model"
+	workflow2 "github.com/coze-dev/coze-studio/backend/domain/workflow"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/execute"
@@ -135,6 +137,8 @@ func newNodeRunConfig[O any](ns *schema2.NodeSchema,
 		})
 	}
 
+	opts.init = append(opts.init, breakpointCheck(ns.Key, ns.Name, ns.Type, meta))
+
 	return &nodeRunConfig[O]{
 		nodeKey:                 ns.Key,
 		nodeName:                ns.Name,
@@ -966,3 +970,69 @@ func keyFinishedMarkerTrimmer() func(ctx context.Context, in map[string]any) (ma
 		return out, err
 	}
 }
+
+const breakpointPassedKey = "&breakpoint_passed"
+
+// breakpointCheck returns a node init hook that pauses a debug TestRun the first time execution
+// reaches a node listed in ExeCfg.Breakpoints, the same way an interactive node (question, input,
+// etc.) interrupts: it emits an InterruptEventBreakpoint and lets the caller inspect the run so
+// far, then resume past it with a TestResume call. It is a no-op for every other node and for
+// non-debug runs.
+func breakpointCheck(nodeKey vo.NodeKey, nodeName string, nodeType entity.NodeType, meta *entity.NodeTypeMeta) func(ctx context.Context) (context.Context, error) {
+	return func(ctx context.Context) (context.Context, error) {
+		exeCfg := execute.GetExeCtx(ctx).ExeCfg
+		if exeCfg.Mode != model.ExecuteModeDebug || len(exeCfg.Breakpoints) == 0 {
+			return ctx, nil
+		}
+
+		isBreakpoint := false
+		for _, id := range exeCfg.Breakpoints {
+			if vo.NodeKey(id) == nodeKey {
+				isBreakpoint = true
+				break
+			}
+		}
+		if !isBreakpoint {
+			return ctx, nil
+		}
+
+		var resumed bool
+		_ = compose.ProcessState(ctx, func(_ context.Context, s nodes.InterruptEventStore) error {
+			_, resumed = s.GetAndClearResumeData(nodeKey)
+			return nil
+		})
+		if resumed {
+			return ctx, nil
+		}
+
+		var previouslyInterrupted bool
+		_ = compose.ProcessState(ctx, func(_ context.Context, state nodes.IntermediateResultStore) error {
+			irs := state.GetIntermediateResult(nodeKey)
+			if len(irs) > 0 {
+				_, previouslyInterrupted = irs[breakpointPassedKey]
+			}
+			if !previouslyInterrupted {
+				state.SetIntermediateResult(nodeKey, map[string]any{breakpointPassedKey: true})
+			}
+			return nil
+		})
+
+		if previouslyInterrupted {
+			return nil, compose.InterruptAndRerun
+		}
+
+		eventID, err := workflow2.GetRepository().GenID(ctx)
+		if err != nil {
+			return nil, vo.WrapError(errno.ErrIDGenError, err)
+		}
+
+		return nil, compose.NewInterruptAndRerunErr(&entity.InterruptEvent{
+			ID:        eventID,
+			NodeKey:   nodeKey,
+			NodeType:  nodeType,
+			NodeTitle: nodeName,
+			NodeIcon:  meta.IconURI,
+			EventType: entity.InterruptEventBreakpoint,
+		})
+	}
+}
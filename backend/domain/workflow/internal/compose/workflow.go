@@ -57,6 +57,7 @@ type workflowOptions struct {
 	idAsName                bool
 	parentRequireCheckpoint bool
 	maxNodeCount            int
+	hasBreakpoints          bool
 }
 
 type WorkflowOption func(*workflowOptions)
@@ -80,6 +81,16 @@ func WithMaxNodeCount(c int) WorkflowOption {
 	}
 }
 
+// WithBreakpoints configures the node IDs a debug TestRun should pause at. Any node in this
+// set that the execution reaches interrupts the run (as if it were an interactive node), so
+// the breakpoint forces checkpointing to be enabled for the whole workflow regardless of
+// whether it otherwise contains any naturally-interrupting node.
+func WithBreakpoints(nodeIDs []string) WorkflowOption {
+	return func(opts *workflowOptions) {
+		opts.hasBreakpoints = len(nodeIDs) > 0
+	}
+}
+
 func NewWorkflow(ctx context.Context, sc *schema.WorkflowSchema, opts ...WorkflowOption) (*Workflow, error) {
 	sc.Init()
 
@@ -108,6 +119,10 @@ func NewWorkflow(ctx context.Context, sc *schema.WorkflowSchema, opts ...Workflo
 		wf.requireCheckpoint = true
 	}
 
+	if wfOpts.hasBreakpoints {
+		wf.requireCheckpoint = true
+	}
+
 	wf.input = sc.GetNode(entity.EntryNodeKey).OutputTypes
 
 	// even if the terminate plan is use answer content, this still will be 'input types' of exit node
@@ -29,6 +29,7 @@ import (
 	workflowModel "github.com/coze-dev/coze-studio/backend/crossdomain/workflow/model"
 	"github.com/coze-dev/coze-studio/backend/pkg/lang/slices"
 
+	workflow3 "github.com/coze-dev/coze-studio/backend/api/model/workflow"
 	wf "github.com/coze-dev/coze-studio/backend/domain/workflow"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
@@ -53,6 +54,13 @@ type workflowTool struct {
 	sc            *schema2.WorkflowSchema
 	repo          wf.Repository
 	terminatePlan vo.TerminatePlan
+	responseStyle *workflow3.ResponseStyle
+}
+
+// respondsRaw reports whether this tool-use should return the sub-workflow's raw output
+// variables instead of following its own TerminatePlan, per the calling LLM node's FC setting.
+func (wt *workflowTool) respondsRaw() bool {
+	return wt.responseStyle != nil && vo.ResponseStyleMode(wt.responseStyle.Mode) == vo.ResponseStyleModeSkipLLM
 }
 
 func NewInvokableWorkflow(info *schema.ToolInfo,
@@ -61,6 +69,7 @@ func NewInvokableWorkflow(info *schema.ToolInfo,
 	wfEntity *entity.Workflow,
 	sc *schema2.WorkflowSchema,
 	repo wf.Repository,
+	responseStyle *workflow3.ResponseStyle,
 ) wf.ToolFromWorkflow {
 	return &invokableWorkflow{
 		workflowTool: workflowTool{
@@ -69,6 +78,7 @@ func NewInvokableWorkflow(info *schema.ToolInfo,
 			sc:            sc,
 			repo:          repo,
 			terminatePlan: terminatePlan,
+			responseStyle: responseStyle,
 		},
 		invoke: invoke,
 	}
@@ -207,7 +217,7 @@ func (i *invokableWorkflow) InvokableRun(ctx context.Context, argumentsInJSON st
 		return "", err
 	}
 
-	if i.terminatePlan == vo.ReturnVariables {
+	if i.terminatePlan == vo.ReturnVariables || i.respondsRaw() {
 		contentStr, err = sonic.MarshalString(out)
 		if err != nil {
 			return "", err
@@ -270,6 +280,7 @@ func NewStreamableWorkflow(info *schema.ToolInfo,
 	wfEntity *entity.Workflow,
 	sc *schema2.WorkflowSchema,
 	repo wf.Repository,
+	responseStyle *workflow3.ResponseStyle,
 ) wf.ToolFromWorkflow {
 	return &streamableWorkflow{
 		workflowTool: workflowTool{
@@ -278,6 +289,7 @@ func NewStreamableWorkflow(info *schema.ToolInfo,
 			sc:            sc,
 			repo:          repo,
 			terminatePlan: terminatePlan,
+			responseStyle: responseStyle,
 		},
 		stream: stream,
 	}
@@ -22,26 +22,29 @@ import (
 )
 
 const (
-	foregroundRunTimeout     = 0 // timeout for workflow execution in foreground mode, 0 means no timeout
-	backgroundRunTimeout     = 0 // timeout for workflow execution in background mode, 0 means no timeout
-	maxNodeCountPerWorkflow  = 0 // maximum node count for a workflow, 0 means no limit
-	maxNodeCountPerExecution = 0 // maximum node count for a workflow execution, 0 means no limit
-	cancelCheckInterval      = 200 * time.Millisecond
+	foregroundRunTimeout          = 0   // timeout for workflow execution in foreground mode, 0 means no timeout
+	backgroundRunTimeout          = 0   // timeout for workflow execution in background mode, 0 means no timeout
+	maxNodeCountPerWorkflow       = 0   // maximum node count for a workflow, 0 means no limit
+	maxNodeCountPerExecution      = 0   // maximum node count for a workflow execution, 0 means no limit
+	maxInterruptCountPerExecution = 100 // maximum number of times an execution may interrupt across all of its resumes, 0 means no limit
+	cancelCheckInterval           = 200 * time.Millisecond
 )
 
 type StaticConfig struct {
-	ForegroundRunTimeout     time.Duration
-	BackgroundRunTimeout     time.Duration
-	MaxNodeCountPerWorkflow  int
-	MaxNodeCountPerExecution int
+	ForegroundRunTimeout          time.Duration
+	BackgroundRunTimeout          time.Duration
+	MaxNodeCountPerWorkflow       int
+	MaxNodeCountPerExecution      int
+	MaxInterruptCountPerExecution int
 }
 
 func GetStaticConfig() *StaticConfig {
 	return &StaticConfig{
-		ForegroundRunTimeout:     foregroundRunTimeout,
-		BackgroundRunTimeout:     backgroundRunTimeout,
-		MaxNodeCountPerWorkflow:  maxNodeCountPerWorkflow,
-		MaxNodeCountPerExecution: maxNodeCountPerExecution,
+		ForegroundRunTimeout:          foregroundRunTimeout,
+		BackgroundRunTimeout:          backgroundRunTimeout,
+		MaxNodeCountPerWorkflow:       maxNodeCountPerWorkflow,
+		MaxNodeCountPerExecution:      maxNodeCountPerExecution,
+		MaxInterruptCountPerExecution: maxInterruptCountPerExecution,
 	}
 }
 
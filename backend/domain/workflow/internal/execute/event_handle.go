@@ -284,6 +284,41 @@ func handleEvent(ctx context.Context, event *Event, repo workflow.Repository,
 			return noTerminate, fmt.Errorf("failed to update workflow execution to interrupted for execution id %d, current status is %v", exeID, currentStatus)
 		}
 
+		interruptCount, err := repo.IncrementInterruptCount(ctx, exeID)
+		if err != nil {
+			return noTerminate, fmt.Errorf("failed to increment interrupt count: %v", err)
+		}
+
+		if maxCount := GetStaticConfig().MaxInterruptCountPerExecution; maxCount > 0 && int(interruptCount) > maxCount {
+			wfe := vo.WrapError(errno.ErrMaxInterruptCountExceeded,
+				fmt.Errorf("execution %d interrupted %d times, exceeding the limit of %d", exeID, interruptCount, maxCount),
+				errorx.KV("id", strconv.FormatInt(exeID, 10)), errorx.KV("count", strconv.Itoa(int(interruptCount))), errorx.KV("max", strconv.Itoa(maxCount)))
+
+			wfExecFail := &entity.WorkflowExecution{
+				ID:         exeID,
+				Duration:   event.Duration,
+				Status:     entity.WorkflowFailed,
+				ErrorCode:  ptr.Of(strconv.Itoa(int(wfe.Code()))),
+				FailReason: ptr.Of(wfe.Msg()),
+			}
+			if _, _, err = repo.UpdateWorkflowExecution(ctx, wfExecFail, []entity.WorkflowExecuteStatus{entity.WorkflowInterrupted}); err != nil {
+				return noTerminate, fmt.Errorf("failed to save workflow execution when exceeding max interrupt count: %v", err)
+			}
+
+			if sw != nil && event.SubWorkflowCtx == nil {
+				sw.Send(&entity.Message{
+					StateMessage: &entity.StateMessage{
+						ExecuteID: event.RootExecuteID,
+						EventID:   event.GetResumedEventID(),
+						Status:    entity.WorkflowFailed,
+						LastError: wfe,
+					},
+				}, nil)
+			}
+
+			return workflowAbort, nil
+		}
+
 		if event.RootCtx.ResumeEvent != nil && !event.RootCtx.ResumeEvent.Popped {
 			needPop := false
 			for _, ie := range event.InterruptEvents {
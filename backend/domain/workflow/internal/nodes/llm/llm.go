@@ -429,6 +429,7 @@ func (c *Config) Build(ctx context.Context, ns *schema2.NodeSchema, _ ...schema2
 				if wf.FCSetting != nil {
 					workflowToolConfig.InputParametersConfig = wf.FCSetting.RequestParameters
 					workflowToolConfig.OutputParametersConfig = wf.FCSetting.ResponseParameters
+					workflowToolConfig.ResponseStyle = wf.FCSetting.ResponseStyle
 				}
 
 				locator := workflowModel.FromDraft
@@ -37,6 +37,10 @@ import (
 
 func ExecutePlugin(ctx context.Context, input map[string]any, pe *vo.PluginEntity,
 	toolID int64, cfg workflowModel.ExecuteConfig) (map[string]any, error) {
+	if err := checkToolRateLimit(toolID); err != nil {
+		return nil, err
+	}
+
 	args, err := sonic.MarshalString(input)
 	if err != nil {
 		return nil, vo.WrapError(errno.ErrSerializationDeserializationFail, err)
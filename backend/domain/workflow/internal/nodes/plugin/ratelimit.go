@@ -0,0 +1,28 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package plugin
+
+import (
+	pluginConf "github.com/coze-dev/coze-studio/backend/domain/plugin/conf"
+)
+
+// checkToolRateLimit throttles calls to toolID against the per-second/per-minute limits
+// configured on its plugin product metadata, preventing a batch node from exceeding a plugin
+// backend's rate limit. See pluginConf.CheckToolRateLimit, which holds the shared rate windows.
+func checkToolRateLimit(toolID int64) error {
+	return pluginConf.CheckToolRateLimit(toolID)
+}
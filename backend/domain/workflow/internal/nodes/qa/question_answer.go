@@ -721,6 +721,11 @@ func (q *QuestionAnswer) interrupt(ctx context.Context, newQuestion string, choi
 		return err
 	}
 
+	inputSchema, err := buildResumeInputSchema(q.answerType, choices)
+	if err != nil {
+		return err
+	}
+
 	event := &entity.InterruptEvent{
 		ID:            eventID,
 		NodeKey:       q.nodeKey,
@@ -729,6 +734,7 @@ func (q *QuestionAnswer) interrupt(ctx context.Context, newQuestion string, choi
 		NodeIcon:      q.nodeMeta.IconURI,
 		InterruptData: interruptData,
 		EventType:     entity.InterruptEventQuestion,
+		InputSchema:   inputSchema,
 	}
 
 	intermediateResult := map[string]any{
@@ -750,6 +756,17 @@ func (q *QuestionAnswer) interrupt(ctx context.Context, newQuestion string, choi
 	return compose.NewInterruptAndRerunErr(event)
 }
 
+// buildResumeInputSchema describes the shape of the resume data this interrupt expects: free
+// text for AnswerDirectly, or an enum of the offered choices for AnswerByChoices.
+func buildResumeInputSchema(answerType AnswerType, choices []string) (string, error) {
+	schema := map[string]any{"type": "string"}
+	if answerType == AnswerByChoices && len(choices) > 0 {
+		schema["enum"] = choices
+	}
+
+	return sonic.MarshalString(schema)
+}
+
 func intToAlphabet(num int) string {
 	if num >= 0 && num <= 25 {
 		char := rune('A' + num)
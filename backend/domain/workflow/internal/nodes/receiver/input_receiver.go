@@ -83,6 +83,7 @@ func (c *Config) Build(_ context.Context, ns *schema.NodeSchema, _ ...schema.Bui
 		nodeMeta:      *nodeMeta,
 		nodeKey:       ns.Key,
 		interruptData: interruptDataStr,
+		inputSchema:   c.OutputSchema,
 	}, nil
 }
 
@@ -93,6 +94,7 @@ func (c *Config) RequireCheckpoint() bool {
 type InputReceiver struct {
 	outputTypes   map[string]*vo.TypeInfo
 	interruptData string
+	inputSchema   string
 	nodeKey       vo.NodeKey
 	nodeMeta      entity.NodeTypeMeta
 }
@@ -143,6 +145,7 @@ func (i *InputReceiver) Invoke(ctx context.Context, _ map[string]any) (map[strin
 			NodeIcon:      i.nodeMeta.IconURI,
 			InterruptData: i.interruptData,
 			EventType:     entity.InterruptEventInput,
+			InputSchema:   i.inputSchema,
 		})
 	}
 
@@ -31,6 +31,8 @@ type WorkflowMeta struct {
 	AppID           int64          `gorm:"column:app_id;comment:app id" json:"app_id"`                                                                                                                                                                                                                              // app id
 	LatestVersion   string         `gorm:"column:latest_version;comment:the version of the most recent publish" json:"latest_version"`                                                                                                                                                                              // the version of the most recent publish
 	LatestVersionTs int64          `gorm:"column:latest_version_ts;comment:create time of latest version" json:"latest_version_ts"`                                                                                                                                                                                 // create time of latest version
+	CacheEnabled    bool           `gorm:"column:cache_enabled;not null;comment:whether OpenAPIRun results are cached by version and normalized input" json:"cache_enabled"`                                                                                                                                        // whether OpenAPIRun results are cached by version and normalized input
+	CacheTTLSeconds int32          `gorm:"column:cache_ttl_seconds;comment:how long a cached OpenAPIRun result stays valid, in seconds" json:"cache_ttl_seconds"`                                                                                                                                                   // how long a cached OpenAPIRun result stays valid, in seconds
 }
 
 // TableName WorkflowMeta's table name
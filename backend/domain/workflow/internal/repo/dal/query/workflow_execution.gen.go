@@ -54,6 +54,8 @@ func newWorkflowExecution(db *gorm.DB, opts ...gen.DOOption) workflowExecution {
 	_workflowExecution.AgentID = field.NewInt64(tableName, "agent_id")
 	_workflowExecution.SyncPattern = field.NewInt32(tableName, "sync_pattern")
 	_workflowExecution.CommitID = field.NewString(tableName, "commit_id")
+	_workflowExecution.InterruptCount = field.NewInt32(tableName, "interrupt_count")
+	_workflowExecution.ExperimentVariant = field.NewString(tableName, "experiment_variant")
 
 	_workflowExecution.fillFieldMap()
 
@@ -64,34 +66,36 @@ func newWorkflowExecution(db *gorm.DB, opts ...gen.DOOption) workflowExecution {
 type workflowExecution struct {
 	workflowExecutionDo
 
-	ALL             field.Asterisk
-	ID              field.Int64  // execute id
-	WorkflowID      field.Int64  // workflow_id
-	Version         field.String // workflow version. empty if is draft
-	SpaceID         field.Int64  // the space id the workflow belongs to
-	Mode            field.Int32  // the execution mode: 1. debug run 2. release run 3. node debug
-	OperatorID      field.Int64  // the user id that runs this workflow
-	ConnectorID     field.Int64  // the connector on which this execution happened
-	ConnectorUID    field.String // user id of the connector
-	CreatedAt       field.Int64  // create time in millisecond
-	LogID           field.String // log id
-	Status          field.Int32  // 1=running 2=success 3=fail 4=interrupted
-	Duration        field.Int64  // execution duration in millisecond
-	Input           field.String // actual input of this execution
-	Output          field.String // the actual output of this execution
-	ErrorCode       field.String // error code if any
-	FailReason      field.String // the reason for failure
-	InputTokens     field.Int64  // number of input tokens
-	OutputTokens    field.Int64  // number of output tokens
-	UpdatedAt       field.Int64  // update time in millisecond
-	RootExecutionID field.Int64  // the top level execution id. Null if this is the root
-	ParentNodeID    field.String // the node key for the sub_workflow node that executes this workflow
-	AppID           field.Int64  // app id this workflow execution belongs to
-	NodeCount       field.Int32  // the total node count of the workflow
-	ResumeEventID   field.Int64  // the current event ID which is resuming
-	AgentID         field.Int64  // the agent that this execution binds to
-	SyncPattern     field.Int32  // the sync pattern 1. sync 2. async 3. stream
-	CommitID        field.String // draft commit id this execution belongs to
+	ALL               field.Asterisk
+	ID                field.Int64  // execute id
+	WorkflowID        field.Int64  // workflow_id
+	Version           field.String // workflow version. empty if is draft
+	SpaceID           field.Int64  // the space id the workflow belongs to
+	Mode              field.Int32  // the execution mode: 1. debug run 2. release run 3. node debug
+	OperatorID        field.Int64  // the user id that runs this workflow
+	ConnectorID       field.Int64  // the connector on which this execution happened
+	ConnectorUID      field.String // user id of the connector
+	CreatedAt         field.Int64  // create time in millisecond
+	LogID             field.String // log id
+	Status            field.Int32  // 1=running 2=success 3=fail 4=interrupted
+	Duration          field.Int64  // execution duration in millisecond
+	Input             field.String // actual input of this execution
+	Output            field.String // the actual output of this execution
+	ErrorCode         field.String // error code if any
+	FailReason        field.String // the reason for failure
+	InputTokens       field.Int64  // number of input tokens
+	OutputTokens      field.Int64  // number of output tokens
+	UpdatedAt         field.Int64  // update time in millisecond
+	RootExecutionID   field.Int64  // the top level execution id. Null if this is the root
+	ParentNodeID      field.String // the node key for the sub_workflow node that executes this workflow
+	AppID             field.Int64  // app id this workflow execution belongs to
+	NodeCount         field.Int32  // the total node count of the workflow
+	ResumeEventID     field.Int64  // the current event ID which is resuming
+	AgentID           field.Int64  // the agent that this execution binds to
+	SyncPattern       field.Int32  // the sync pattern 1. sync 2. async 3. stream
+	CommitID          field.String // draft commit id this execution belongs to
+	InterruptCount    field.Int32  // the number of times this execution has interrupted across all of its resumes
+	ExperimentVariant field.String // caller-supplied experiment/variant label for A/B comparison
 
 	fieldMap map[string]field.Expr
 }
@@ -135,6 +139,8 @@ func (w *workflowExecution) updateTableName(table string) *workflowExecution {
 	w.AgentID = field.NewInt64(table, "agent_id")
 	w.SyncPattern = field.NewInt32(table, "sync_pattern")
 	w.CommitID = field.NewString(table, "commit_id")
+	w.InterruptCount = field.NewInt32(table, "interrupt_count")
+	w.ExperimentVariant = field.NewString(table, "experiment_variant")
 
 	w.fillFieldMap()
 
@@ -151,7 +157,7 @@ func (w *workflowExecution) GetFieldByName(fieldName string) (field.OrderExpr, b
 }
 
 func (w *workflowExecution) fillFieldMap() {
-	w.fieldMap = make(map[string]field.Expr, 27)
+	w.fieldMap = make(map[string]field.Expr, 29)
 	w.fieldMap["id"] = w.ID
 	w.fieldMap["workflow_id"] = w.WorkflowID
 	w.fieldMap["version"] = w.Version
@@ -179,6 +185,8 @@ func (w *workflowExecution) fillFieldMap() {
 	w.fieldMap["agent_id"] = w.AgentID
 	w.fieldMap["sync_pattern"] = w.SyncPattern
 	w.fieldMap["commit_id"] = w.CommitID
+	w.fieldMap["interrupt_count"] = w.InterruptCount
+	w.fieldMap["experiment_variant"] = w.ExperimentVariant
 }
 
 func (w workflowExecution) clone(db *gorm.DB) workflowExecution {
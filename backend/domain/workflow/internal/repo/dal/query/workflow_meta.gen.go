@@ -46,6 +46,8 @@ func newWorkflowMeta(db *gorm.DB, opts ...gen.DOOption) workflowMeta {
 	_workflowMeta.AppID = field.NewInt64(tableName, "app_id")
 	_workflowMeta.LatestVersion = field.NewString(tableName, "latest_version")
 	_workflowMeta.LatestVersionTs = field.NewInt64(tableName, "latest_version_ts")
+	_workflowMeta.CacheEnabled = field.NewBool(tableName, "cache_enabled")
+	_workflowMeta.CacheTTLSeconds = field.NewInt32(tableName, "cache_ttl_seconds")
 
 	_workflowMeta.fillFieldMap()
 
@@ -76,6 +78,8 @@ type workflowMeta struct {
 	AppID           field.Int64  // app id
 	LatestVersion   field.String // the version of the most recent publish
 	LatestVersionTs field.Int64  // create time of latest version
+	CacheEnabled    field.Bool   // whether OpenAPIRun results are cached by version and normalized input
+	CacheTTLSeconds field.Int32  // how long a cached OpenAPIRun result stays valid, in seconds
 
 	fieldMap map[string]field.Expr
 }
@@ -111,6 +115,8 @@ func (w *workflowMeta) updateTableName(table string) *workflowMeta {
 	w.AppID = field.NewInt64(table, "app_id")
 	w.LatestVersion = field.NewString(table, "latest_version")
 	w.LatestVersionTs = field.NewInt64(table, "latest_version_ts")
+	w.CacheEnabled = field.NewBool(table, "cache_enabled")
+	w.CacheTTLSeconds = field.NewInt32(table, "cache_ttl_seconds")
 
 	w.fillFieldMap()
 
@@ -127,7 +133,7 @@ func (w *workflowMeta) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (w *workflowMeta) fillFieldMap() {
-	w.fieldMap = make(map[string]field.Expr, 19)
+	w.fieldMap = make(map[string]field.Expr, 21)
 	w.fieldMap["id"] = w.ID
 	w.fieldMap["name"] = w.Name
 	w.fieldMap["description"] = w.Description
@@ -147,6 +153,8 @@ func (w *workflowMeta) fillFieldMap() {
 	w.fieldMap["app_id"] = w.AppID
 	w.fieldMap["latest_version"] = w.LatestVersion
 	w.fieldMap["latest_version_ts"] = w.LatestVersionTs
+	w.fieldMap["cache_enabled"] = w.CacheEnabled
+	w.fieldMap["cache_ttl_seconds"] = w.CacheTTLSeconds
 }
 
 func (w workflowMeta) clone(db *gorm.DB) workflowMeta {
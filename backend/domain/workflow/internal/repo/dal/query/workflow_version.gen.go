@@ -38,6 +38,8 @@ func newWorkflowVersion(db *gorm.DB, opts ...gen.DOOption) workflowVersion {
 	_workflowVersion.CreatedAt = field.NewInt64(tableName, "created_at")
 	_workflowVersion.DeletedAt = field.NewField(tableName, "deleted_at")
 	_workflowVersion.CommitID = field.NewString(tableName, "commit_id")
+	_workflowVersion.Deprecated = field.NewBool(tableName, "deprecated")
+	_workflowVersion.DeprecationMessage = field.NewString(tableName, "deprecation_message")
 
 	_workflowVersion.fillFieldMap()
 
@@ -60,6 +62,8 @@ type workflowVersion struct {
 	CreatedAt          field.Int64  // Create Time in Milliseconds
 	DeletedAt          field.Field  // Delete Time
 	CommitID           field.String // the commit id corresponding to this version
+	Deprecated         field.Bool   // whether this version is deprecated
+	DeprecationMessage field.String // message shown to callers of a deprecated version
 
 	fieldMap map[string]field.Expr
 }
@@ -87,6 +91,8 @@ func (w *workflowVersion) updateTableName(table string) *workflowVersion {
 	w.CreatedAt = field.NewInt64(table, "created_at")
 	w.DeletedAt = field.NewField(table, "deleted_at")
 	w.CommitID = field.NewString(table, "commit_id")
+	w.Deprecated = field.NewBool(table, "deprecated")
+	w.DeprecationMessage = field.NewString(table, "deprecation_message")
 
 	w.fillFieldMap()
 
@@ -103,7 +109,7 @@ func (w *workflowVersion) GetFieldByName(fieldName string) (field.OrderExpr, boo
 }
 
 func (w *workflowVersion) fillFieldMap() {
-	w.fieldMap = make(map[string]field.Expr, 11)
+	w.fieldMap = make(map[string]field.Expr, 13)
 	w.fieldMap["id"] = w.ID
 	w.fieldMap["workflow_id"] = w.WorkflowID
 	w.fieldMap["version"] = w.Version
@@ -115,6 +121,8 @@ func (w *workflowVersion) fillFieldMap() {
 	w.fieldMap["created_at"] = w.CreatedAt
 	w.fieldMap["deleted_at"] = w.DeletedAt
 	w.fieldMap["commit_id"] = w.CommitID
+	w.fieldMap["deprecated"] = w.Deprecated
+	w.fieldMap["deprecation_message"] = w.DeprecationMessage
 }
 
 func (w workflowVersion) clone(db *gorm.DB) workflowVersion {
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coze-dev/coze-studio/backend/infra/cache/impl/redis"
+)
+
+func newTestEditLockStore(t *testing.T) *editLockStoreImpl {
+	t.Helper()
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+
+	return &editLockStoreImpl{redis: redis.NewWithAddrAndPassword(mr.Addr(), "")}
+}
+
+func TestEditLockStore_AcquireGrantsToFirstHolder(t *testing.T) {
+	store := newTestEditLockStore(t)
+	ctx := context.Background()
+
+	ok, err := store.AcquireEditLock(ctx, 1, 100, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	lock, found, err := store.GetEditLock(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, int64(100), lock.HolderID)
+}
+
+func TestEditLockStore_AcquireDeniesWhileHeldByAnotherHolder(t *testing.T) {
+	store := newTestEditLockStore(t)
+	ctx := context.Background()
+
+	ok, err := store.AcquireEditLock(ctx, 1, 100, time.Minute)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = store.AcquireEditLock(ctx, 1, 200, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestEditLockStore_AcquireIsReentrantAndRenewsTTLForCurrentHolder(t *testing.T) {
+	store := newTestEditLockStore(t)
+	ctx := context.Background()
+
+	ok, err := store.AcquireEditLock(ctx, 1, 100, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// renew via the SetNX+Get+Expire path before the short TTL elapses
+	ok, err = store.AcquireEditLock(ctx, 1, 100, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	time.Sleep(100 * time.Millisecond)
+
+	lock, found, err := store.GetEditLock(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, int64(100), lock.HolderID)
+}
+
+func TestEditLockStore_ReleaseByNonHolderIsNoop(t *testing.T) {
+	store := newTestEditLockStore(t)
+	ctx := context.Background()
+
+	ok, err := store.AcquireEditLock(ctx, 1, 100, time.Minute)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	err = store.ReleaseEditLock(ctx, 1, 200)
+	require.NoError(t, err)
+
+	_, found, err := store.GetEditLock(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, found)
+}
+
+func TestEditLockStore_ReleaseByHolderFreesTheLock(t *testing.T) {
+	store := newTestEditLockStore(t)
+	ctx := context.Background()
+
+	ok, err := store.AcquireEditLock(ctx, 1, 100, time.Minute)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	err = store.ReleaseEditLock(ctx, 1, 100)
+	require.NoError(t, err)
+
+	_, found, err := store.GetEditLock(ctx, 1)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	ok, err = store.AcquireEditLock(ctx, 1, 200, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestEditLockStore_GetEditLockWhenUnheld(t *testing.T) {
+	store := newTestEditLockStore(t)
+	ctx := context.Background()
+
+	_, found, err := store.GetEditLock(ctx, 1)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestEditLockStore_AcquireTreatsExpiredLockAsFree(t *testing.T) {
+	store := newTestEditLockStore(t)
+	ctx := context.Background()
+
+	ok, err := store.AcquireEditLock(ctx, 1, 100, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	time.Sleep(30 * time.Millisecond)
+
+	ok, err = store.AcquireEditLock(ctx, 1, 200, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -72,24 +72,25 @@ func (e *executeHistoryStoreImpl) CreateWorkflowExecution(ctx context.Context, e
 	}
 
 	wfExec := &model.WorkflowExecution{
-		ID:              execution.ID,
-		WorkflowID:      execution.WorkflowID,
-		Version:         execution.Version,
-		SpaceID:         execution.SpaceID,
-		Mode:            mode,
-		OperatorID:      execution.Operator,
-		Status:          int32(entity.WorkflowRunning),
-		Input:           ptr.FromOrDefault(execution.Input, ""),
-		RootExecutionID: execution.RootExecutionID,
-		ParentNodeID:    ptr.FromOrDefault(execution.ParentNodeID, ""),
-		AppID:           ptr.FromOrDefault(execution.AppID, 0),
-		AgentID:         ptr.FromOrDefault(execution.AgentID, 0),
-		ConnectorID:     execution.ConnectorID,
-		ConnectorUID:    execution.ConnectorUID,
-		NodeCount:       execution.NodeCount,
-		SyncPattern:     syncPattern,
-		CommitID:        execution.CommitID,
-		LogID:           execution.LogID,
+		ID:                execution.ID,
+		WorkflowID:        execution.WorkflowID,
+		Version:           execution.Version,
+		SpaceID:           execution.SpaceID,
+		Mode:              mode,
+		OperatorID:        execution.Operator,
+		Status:            int32(entity.WorkflowRunning),
+		Input:             scrubPII(execution.SpaceID, ptr.FromOrDefault(execution.Input, "")),
+		RootExecutionID:   execution.RootExecutionID,
+		ParentNodeID:      ptr.FromOrDefault(execution.ParentNodeID, ""),
+		AppID:             ptr.FromOrDefault(execution.AppID, 0),
+		AgentID:           ptr.FromOrDefault(execution.AgentID, 0),
+		ConnectorID:       execution.ConnectorID,
+		ConnectorUID:      execution.ConnectorUID,
+		NodeCount:         execution.NodeCount,
+		SyncPattern:       syncPattern,
+		CommitID:          execution.CommitID,
+		LogID:             execution.LogID,
+		ExperimentVariant: execution.ExperimentVariant,
 	}
 
 	if execution.ParentNodeID == nil {
@@ -122,7 +123,7 @@ func (e *executeHistoryStoreImpl) UpdateWorkflowExecution(ctx context.Context, e
 	// Use map[string]any to explicitly specify fields for update
 	updateMap := map[string]any{
 		"status":          int32(execution.Status),
-		"output":          ptr.FromOrDefault(execution.Output, ""),
+		"output":          scrubPII(execution.SpaceID, ptr.FromOrDefault(execution.Output, "")),
 		"duration":        execution.Duration.Milliseconds(),
 		"error_code":      ptr.FromOrDefault(execution.ErrorCode, ""),
 		"fail_reason":     ptr.FromOrDefault(execution.FailReason, ""),
@@ -199,6 +200,30 @@ func (e *executeHistoryStoreImpl) TryLockWorkflowExecution(ctx context.Context,
 	return true, entity.WorkflowInterrupted, nil
 }
 
+func (e *executeHistoryStoreImpl) IncrementInterruptCount(ctx context.Context, wfExeID int64) (_ int32, err error) {
+	defer func() {
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrDatabaseError, err)
+		}
+	}()
+
+	if _, err = e.query.WorkflowExecution.WithContext(ctx).
+		Where(e.query.WorkflowExecution.ID.Eq(wfExeID)).
+		UpdateColumn(e.query.WorkflowExecution.InterruptCount, gorm.Expr("interrupt_count + ?", 1)); err != nil {
+		return 0, fmt.Errorf("failed to increment interrupt count: %w", err)
+	}
+
+	wfExe, found, err := e.GetWorkflowExecution(ctx, wfExeID)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("workflow execution not found for ID %d", wfExeID)
+	}
+
+	return wfExe.InterruptCount, nil
+}
+
 func (e *executeHistoryStoreImpl) GetWorkflowExecution(ctx context.Context, id int64) (*entity.WorkflowExecution, bool, error) {
 	rootExes, err := e.query.WorkflowExecution.WithContext(ctx).
 		Where(e.query.WorkflowExecution.ID.Eq(id)).
@@ -211,7 +236,10 @@ func (e *executeHistoryStoreImpl) GetWorkflowExecution(ctx context.Context, id i
 		return nil, false, nil
 	}
 
-	rootExe := rootExes[0]
+	return convertWorkflowExecution(rootExes[0]), true, nil
+}
+
+func convertWorkflowExecution(rootExe *model.WorkflowExecution) *entity.WorkflowExecution {
 	var exeMode workflowModel.ExecuteMode
 	if rootExe.Mode == 1 {
 		exeMode = workflowModel.ExecuteModeDebug
@@ -232,29 +260,31 @@ func (e *executeHistoryStoreImpl) GetWorkflowExecution(ctx context.Context, id i
 	default:
 	}
 
-	exe := &entity.WorkflowExecution{
+	return &entity.WorkflowExecution{
 		ID:         rootExe.ID,
 		WorkflowID: rootExe.WorkflowID,
 		Version:    rootExe.Version,
 		SpaceID:    rootExe.SpaceID,
 		ExecuteConfig: workflowModel.ExecuteConfig{
-			Operator:     rootExe.OperatorID,
-			Mode:         exeMode,
-			AppID:        ternary.IFElse(rootExe.AppID > 0, ptr.Of(rootExe.AppID), nil),
-			AgentID:      ternary.IFElse(rootExe.AgentID > 0, ptr.Of(rootExe.AgentID), nil),
-			ConnectorID:  rootExe.ConnectorID,
-			ConnectorUID: rootExe.ConnectorUID,
-			SyncPattern:  syncPattern,
+			Operator:          rootExe.OperatorID,
+			Mode:              exeMode,
+			AppID:             ternary.IFElse(rootExe.AppID > 0, ptr.Of(rootExe.AppID), nil),
+			AgentID:           ternary.IFElse(rootExe.AgentID > 0, ptr.Of(rootExe.AgentID), nil),
+			ConnectorID:       rootExe.ConnectorID,
+			ConnectorUID:      rootExe.ConnectorUID,
+			SyncPattern:       syncPattern,
+			ExperimentVariant: rootExe.ExperimentVariant,
 		},
-		CreatedAt:  time.UnixMilli(rootExe.CreatedAt),
-		LogID:      rootExe.LogID,
-		NodeCount:  rootExe.NodeCount,
-		Status:     entity.WorkflowExecuteStatus(rootExe.Status),
-		Duration:   time.Duration(rootExe.Duration) * time.Millisecond,
-		Input:      &rootExe.Input,
-		Output:     &rootExe.Output,
-		ErrorCode:  &rootExe.ErrorCode,
-		FailReason: &rootExe.FailReason,
+		CreatedAt:      time.UnixMilli(rootExe.CreatedAt),
+		LogID:          rootExe.LogID,
+		NodeCount:      rootExe.NodeCount,
+		InterruptCount: rootExe.InterruptCount,
+		Status:         entity.WorkflowExecuteStatus(rootExe.Status),
+		Duration:       time.Duration(rootExe.Duration) * time.Millisecond,
+		Input:          &rootExe.Input,
+		Output:         &rootExe.Output,
+		ErrorCode:      &rootExe.ErrorCode,
+		FailReason:     &rootExe.FailReason,
 		TokenInfo: &entity.TokenUsage{
 			InputTokens:  rootExe.InputTokens,
 			OutputTokens: rootExe.OutputTokens,
@@ -267,8 +297,95 @@ func (e *executeHistoryStoreImpl) GetWorkflowExecution(ctx context.Context, id i
 		CurrentResumingEventID: ternary.IFElse(rootExe.ResumeEventID == 0, nil, ptr.Of(rootExe.ResumeEventID)),
 		CommitID:               rootExe.CommitID,
 	}
+}
+
+// GetLatestWorkflowExecutionByStatus returns the most recent execution of wfID by uID whose
+// Status matches status (e.g. the last successful run or the last failed run), so the debug UI
+// can offer "re-run last good inputs" or "inspect last failure" without scanning full history.
+func (e *executeHistoryStoreImpl) GetLatestWorkflowExecutionByStatus(ctx context.Context, wfID int64, uID int64,
+	status entity.WorkflowExecuteStatus) (*entity.WorkflowExecution, bool, error) {
+	rootExe, err := e.query.WorkflowExecution.WithContext(ctx).
+		Where(
+			e.query.WorkflowExecution.WorkflowID.Eq(wfID),
+			e.query.WorkflowExecution.OperatorID.Eq(uID),
+			e.query.WorkflowExecution.Status.Eq(int32(status)),
+		).
+		Order(e.query.WorkflowExecution.CreatedAt.Desc()).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, vo.WrapError(errno.ErrDatabaseError, fmt.Errorf("failed to find workflow execution: %v", err))
+	}
+
+	return convertWorkflowExecution(rootExe), true, nil
+}
+
+// GetVariantStats aggregates wfID's executions created within [from, to) by their
+// ExperimentVariant label, for A/B comparison of success rate and token usage.
+func (e *executeHistoryStoreImpl) GetVariantStats(ctx context.Context, wfID int64, from, to time.Time) (_ []*entity.VariantStats, err error) {
+	defer func() {
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrDatabaseError, err)
+		}
+	}()
+
+	rows, err := e.query.WorkflowExecution.WithContext(ctx).
+		Select(e.query.WorkflowExecution.ExperimentVariant, e.query.WorkflowExecution.Status,
+			e.query.WorkflowExecution.InputTokens, e.query.WorkflowExecution.OutputTokens).
+		Where(
+			e.query.WorkflowExecution.WorkflowID.Eq(wfID),
+			e.query.WorkflowExecution.CreatedAt.Gte(from.UnixMilli()),
+			e.query.WorkflowExecution.CreatedAt.Lt(to.UnixMilli()),
+		).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variant stats for workflow %d: %w", wfID, err)
+	}
 
-	return exe, true, nil
+	statsByVariant := make(map[string]*entity.VariantStats)
+	variants := make([]string, 0)
+	for _, row := range rows {
+		stats, ok := statsByVariant[row.ExperimentVariant]
+		if !ok {
+			stats = &entity.VariantStats{Variant: row.ExperimentVariant}
+			statsByVariant[row.ExperimentVariant] = stats
+			variants = append(variants, row.ExperimentVariant)
+		}
+
+		stats.TotalCount++
+		if entity.WorkflowExecuteStatus(row.Status) == entity.WorkflowSuccess {
+			stats.SuccessCount++
+		}
+		stats.AvgInputTokens += float64(row.InputTokens)
+		stats.AvgOutputTokens += float64(row.OutputTokens)
+	}
+
+	result := make([]*entity.VariantStats, 0, len(variants))
+	for _, variant := range variants {
+		stats := statsByVariant[variant]
+		stats.AvgInputTokens /= float64(stats.TotalCount)
+		stats.AvgOutputTokens /= float64(stats.TotalCount)
+		result = append(result, stats)
+	}
+
+	return result, nil
+}
+
+// spaceIDForExecution looks up the SpaceID of the workflow execution that a node execution
+// belongs to. NodeExecution itself doesn't carry a SpaceID, so this is only consulted when PII
+// scrubbing has at least one space enabled.
+func (e *executeHistoryStoreImpl) spaceIDForExecution(ctx context.Context, executeID int64) int64 {
+	if !piiScrubAnyEnabled() {
+		return 0
+	}
+
+	wfExec, found, err := e.GetWorkflowExecution(ctx, executeID)
+	if err != nil || !found {
+		return 0
+	}
+	return wfExec.SpaceID
 }
 
 func (e *executeHistoryStoreImpl) CreateNodeExecution(ctx context.Context, execution *entity.NodeExecution) error {
@@ -279,7 +396,7 @@ func (e *executeHistoryStoreImpl) CreateNodeExecution(ctx context.Context, execu
 		NodeName:           execution.NodeName,
 		NodeType:           string(execution.NodeType),
 		Status:             int32(entity.NodeRunning),
-		Input:              ptr.FromOrDefault(execution.Input, ""),
+		Input:              scrubPII(e.spaceIDForExecution(ctx, execution.ExecuteID), ptr.FromOrDefault(execution.Input, "")),
 		CompositeNodeIndex: int64(execution.Index),
 		CompositeNodeItems: ptr.FromOrDefault(execution.Items, ""),
 		ParentNodeID:       ptr.FromOrDefault(execution.ParentNodeID, ""),
@@ -318,11 +435,12 @@ func (e *executeHistoryStoreImpl) UpdateNodeExecution(ctx context.Context, execu
 		}
 	}()
 
+	spaceID := e.spaceIDForExecution(ctx, execution.ExecuteID)
 	nodeExec := &model.NodeExecution{
 		Status:     int32(execution.Status),
-		Input:      ptr.FromOrDefault(execution.Input, ""),
-		Output:     ptr.FromOrDefault(execution.Output, ""),
-		RawOutput:  ptr.FromOrDefault(execution.RawOutput, ""),
+		Input:      scrubPII(spaceID, ptr.FromOrDefault(execution.Input, "")),
+		Output:     scrubPII(spaceID, ptr.FromOrDefault(execution.Output, "")),
+		RawOutput:  scrubPII(spaceID, ptr.FromOrDefault(execution.RawOutput, "")),
 		Duration:   execution.Duration.Milliseconds(),
 		ErrorInfo:  ptr.FromOrDefault(execution.ErrorInfo, ""),
 		ErrorLevel: ptr.FromOrDefault(execution.ErrorLevel, ""),
@@ -0,0 +1,176 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repo
+
+import (
+	"regexp"
+	"sync"
+
+	"github.com/coze-dev/coze-studio/backend/pkg/sonic"
+)
+
+// PIIScrubRule describes one rule for scrubbing PII out of a stored execution input/output
+// before it hits the database, reducing data-at-rest exposure rather than redacting it only
+// when read back later (e.g. by GetProcess or history).
+//
+// Exactly one of FieldName or Pattern should be set. FieldName matches a JSON object key at
+// any depth and replaces its value wholesale; Pattern is a regular expression applied to the
+// raw string and replaces each match in place.
+type PIIScrubRule struct {
+	FieldName string
+	Pattern   string
+
+	compiled *regexp.Regexp
+}
+
+// PIIScrubConfig is the set of scrubbing rules applied to spaces that have PII scrubbing
+// enabled, via EnablePIIScrubbingForSpace.
+type PIIScrubConfig struct {
+	Rules []*PIIScrubRule
+	// Replacement is substituted for anything a rule matches. Defaults to "[REDACTED]".
+	Replacement string
+}
+
+const defaultPIIScrubReplacement = "[REDACTED]"
+
+var (
+	piiScrubMu     sync.RWMutex
+	piiScrubConfig *PIIScrubConfig
+	piiScrubSpaces = make(map[int64]bool)
+)
+
+// SetPIIScrubConfig installs the scrubbing rules consulted for any space enabled via
+// EnablePIIScrubbingForSpace. Passing nil disables scrubbing everywhere, which is also the
+// default.
+func SetPIIScrubConfig(cfg *PIIScrubConfig) {
+	piiScrubMu.Lock()
+	defer piiScrubMu.Unlock()
+
+	if cfg != nil {
+		for _, r := range cfg.Rules {
+			if r.Pattern != "" {
+				r.compiled = regexp.MustCompile(r.Pattern)
+			}
+		}
+		if cfg.Replacement == "" {
+			cfg.Replacement = defaultPIIScrubReplacement
+		}
+	}
+
+	piiScrubConfig = cfg
+}
+
+// EnablePIIScrubbingForSpace turns on PII scrubbing of stored execution inputs/outputs for
+// the given space. It has no effect until a config is installed via SetPIIScrubConfig.
+func EnablePIIScrubbingForSpace(spaceID int64) {
+	piiScrubMu.Lock()
+	defer piiScrubMu.Unlock()
+	piiScrubSpaces[spaceID] = true
+}
+
+// DisablePIIScrubbingForSpace turns PII scrubbing back off for the given space.
+func DisablePIIScrubbingForSpace(spaceID int64) {
+	piiScrubMu.Lock()
+	defer piiScrubMu.Unlock()
+	delete(piiScrubSpaces, spaceID)
+}
+
+func piiScrubEnabledForSpace(spaceID int64) bool {
+	piiScrubMu.RLock()
+	defer piiScrubMu.RUnlock()
+	return piiScrubConfig != nil && piiScrubSpaces[spaceID]
+}
+
+// piiScrubAnyEnabled reports whether any space has scrubbing enabled, so callers that would
+// otherwise need an extra lookup just to find a space ID (e.g. for a NodeExecution, which
+// doesn't carry one) can skip it entirely while the feature is unused.
+func piiScrubAnyEnabled() bool {
+	piiScrubMu.RLock()
+	defer piiScrubMu.RUnlock()
+	return piiScrubConfig != nil && len(piiScrubSpaces) > 0
+}
+
+// scrubPII applies the installed PIIScrubConfig's rules to raw, if scrubbing is enabled for
+// spaceID. raw is expected to be a JSON document (as WorkflowExecution/NodeExecution inputs and
+// outputs always are); values are matched against field-name rules after being unmarshaled, and
+// the whole string is matched against regex rules either way. If raw isn't valid JSON, or no
+// scrubbing is configured for spaceID, it is returned unchanged.
+func scrubPII(spaceID int64, raw string) string {
+	if raw == "" {
+		return raw
+	}
+
+	piiScrubMu.RLock()
+	cfg := piiScrubConfig
+	enabled := cfg != nil && piiScrubSpaces[spaceID]
+	piiScrubMu.RUnlock()
+
+	if !enabled {
+		return raw
+	}
+
+	scrubbed := raw
+	for _, r := range cfg.Rules {
+		if r.compiled == nil {
+			continue
+		}
+		scrubbed = r.compiled.ReplaceAllString(scrubbed, cfg.Replacement)
+	}
+
+	fieldNames := make(map[string]bool)
+	for _, r := range cfg.Rules {
+		if r.FieldName != "" {
+			fieldNames[r.FieldName] = true
+		}
+	}
+	if len(fieldNames) == 0 {
+		return scrubbed
+	}
+
+	var doc any
+	if err := sonic.UnmarshalString(scrubbed, &doc); err != nil {
+		return scrubbed
+	}
+
+	scrubFieldsInJSON(doc, fieldNames, cfg.Replacement)
+
+	out, err := sonic.MarshalString(doc)
+	if err != nil {
+		return scrubbed
+	}
+
+	return out
+}
+
+// scrubFieldsInJSON walks a JSON tree decoded into any, replacing the value of any object key
+// in fieldNames with replacement, in place.
+func scrubFieldsInJSON(node any, fieldNames map[string]bool, replacement string) {
+	switch v := node.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if fieldNames[k] {
+				v[k] = replacement
+				continue
+			}
+			scrubFieldsInJSON(val, fieldNames, replacement)
+		}
+	case []any:
+		for _, item := range v {
+			scrubFieldsInJSON(item, fieldNames, replacement)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrubPII_DisabledByDefault(t *testing.T) {
+	SetPIIScrubConfig(nil)
+
+	raw := `{"email":"a@b.com"}`
+	assert.Equal(t, raw, scrubPII(1, raw))
+}
+
+func TestScrubPII_FieldNameRule(t *testing.T) {
+	SetPIIScrubConfig(&PIIScrubConfig{
+		Rules: []*PIIScrubRule{{FieldName: "email"}},
+	})
+	EnablePIIScrubbingForSpace(1)
+	defer func() {
+		SetPIIScrubConfig(nil)
+		DisablePIIScrubbingForSpace(1)
+	}()
+
+	got := scrubPII(1, `{"email":"a@b.com","name":"alice"}`)
+	assert.Contains(t, got, `"[REDACTED]"`)
+	assert.Contains(t, got, `"alice"`)
+	assert.NotContains(t, got, "a@b.com")
+}
+
+func TestScrubPII_RegexRule(t *testing.T) {
+	SetPIIScrubConfig(&PIIScrubConfig{
+		Rules: []*PIIScrubRule{{Pattern: `\d{3}-\d{2}-\d{4}`}},
+	})
+	EnablePIIScrubbingForSpace(2)
+	defer func() {
+		SetPIIScrubConfig(nil)
+		DisablePIIScrubbingForSpace(2)
+	}()
+
+	got := scrubPII(2, `{"ssn":"123-45-6789"}`)
+	assert.NotContains(t, got, "123-45-6789")
+	assert.Contains(t, got, defaultPIIScrubReplacement)
+}
+
+func TestScrubPII_NotEnabledForSpace(t *testing.T) {
+	SetPIIScrubConfig(&PIIScrubConfig{
+		Rules: []*PIIScrubRule{{FieldName: "email"}},
+	})
+	EnablePIIScrubbingForSpace(3)
+	defer func() {
+		SetPIIScrubConfig(nil)
+		DisablePIIScrubbingForSpace(3)
+	}()
+
+	raw := `{"email":"a@b.com"}`
+	assert.Equal(t, raw, scrubPII(999, raw))
+}
@@ -68,6 +68,9 @@ type RepositoryImpl struct {
 	workflow.InterruptEventStore
 	workflow.CancelSignalStore
 	workflow.ExecuteHistoryStore
+	workflow.EditLockStore
+	workflow.TestRunPresetStore
+	workflow.ResultCacheStore
 	builtinModel modelbuilder.BaseChatModel
 	workflow.WorkflowConfig
 	workflow.Suggester
@@ -86,6 +89,8 @@ func NewRepository(idgen idgen.IDGenerator, db *gorm.DB, redis cache.Cmdable, to
 		logs.Warnf("[NewRepository] Failed to create suggester: %v", err)
 	}
 
+	applyPIIScrubConfig(workflowConfig)
+
 	return &RepositoryImpl{
 		IDGenerator:     idgen,
 		query:           query.Use(db),
@@ -98,6 +103,15 @@ func NewRepository(idgen idgen.IDGenerator, db *gorm.DB, redis cache.Cmdable, to
 		CancelSignalStore: &cancelSignalStoreImpl{
 			redis: redis,
 		},
+		EditLockStore: &editLockStoreImpl{
+			redis: redis,
+		},
+		TestRunPresetStore: &testRunPresetStoreImpl{
+			redis: redis,
+		},
+		ResultCacheStore: &resultCacheStoreImpl{
+			redis: redis,
+		},
 		ExecuteHistoryStore: &executeHistoryStoreImpl{
 			query: query.Use(db),
 			redis: redis,
@@ -110,6 +124,30 @@ func NewRepository(idgen idgen.IDGenerator, db *gorm.DB, redis cache.Cmdable, to
 
 }
 
+// applyPIIScrubConfig installs workflowConfig's PIIScrub settings, if any, as the process-wide
+// scrubbing config consulted by scrubPII, so the feature can actually be enabled by an operator
+// instead of only by tests calling SetPIIScrubConfig/EnablePIIScrubbingForSpace directly.
+func applyPIIScrubConfig(workflowConfig workflow.WorkflowConfig) {
+	cfg := workflowConfig.GetPIIScrub()
+	if cfg == nil {
+		return
+	}
+
+	rules := make([]*PIIScrubRule, 0, len(cfg.Rules))
+	for _, r := range cfg.Rules {
+		rules = append(rules, &PIIScrubRule{FieldName: r.FieldName, Pattern: r.Pattern})
+	}
+
+	SetPIIScrubConfig(&PIIScrubConfig{
+		Rules:       rules,
+		Replacement: cfg.Replacement,
+	})
+
+	for _, spaceID := range cfg.EnabledSpaceIDs {
+		EnablePIIScrubbingForSpace(spaceID)
+	}
+}
+
 func (r *RepositoryImpl) Suggest(ctx context.Context, input *vo.SuggestInfo) ([]string, error) {
 	if r.Suggester == nil {
 		return []string{}, nil
@@ -464,16 +502,18 @@ func (r *RepositoryImpl) convertMeta(ctx context.Context, meta *model.WorkflowMe
 	}
 	// Initialize the result entity
 	wfMeta := &vo.Meta{
-		Name:        meta.Name,
-		Desc:        meta.Description,
-		IconURI:     meta.IconURI,
-		IconURL:     url,
-		ContentType: entity.ContentType(meta.ContentType),
-		Mode:        entity.Mode(meta.Mode),
-		CreatorID:   meta.CreatorID,
-		AuthorID:    meta.AuthorID,
-		SpaceID:     meta.SpaceID,
-		CreatedAt:   time.UnixMilli(meta.CreatedAt),
+		Name:            meta.Name,
+		Desc:            meta.Description,
+		IconURI:         meta.IconURI,
+		IconURL:         url,
+		ContentType:     entity.ContentType(meta.ContentType),
+		Mode:            entity.Mode(meta.Mode),
+		CreatorID:       meta.CreatorID,
+		AuthorID:        meta.AuthorID,
+		SpaceID:         meta.SpaceID,
+		CreatedAt:       time.UnixMilli(meta.CreatedAt),
+		CacheEnabled:    meta.CacheEnabled,
+		CacheTTLSeconds: meta.CacheTTLSeconds,
 	}
 	if meta.Tag != 0 {
 		tag := entity.Tag(meta.Tag)
@@ -529,6 +569,14 @@ func (r *RepositoryImpl) UpdateMeta(ctx context.Context, id int64, metaUpdate *v
 		expressions = append(expressions, r.query.WorkflowMeta.Mode.Value(int32(*metaUpdate.WorkflowMode)))
 	}
 
+	if metaUpdate.CacheEnabled != nil {
+		expressions = append(expressions, r.query.WorkflowMeta.CacheEnabled.Value(*metaUpdate.CacheEnabled))
+	}
+
+	if metaUpdate.CacheTTLSeconds != nil {
+		expressions = append(expressions, r.query.WorkflowMeta.CacheTTLSeconds.Value(*metaUpdate.CacheTTLSeconds))
+	}
+
 	if len(expressions) == 0 {
 		return nil
 	}
@@ -769,6 +817,8 @@ func (r *RepositoryImpl) GetVersion(ctx context.Context, id int64, version strin
 			VersionDescription: wfVersion.VersionDescription,
 			VersionCreatedAt:   time.UnixMilli(wfVersion.CreatedAt),
 			VersionCreatorID:   wfVersion.CreatorID,
+			Deprecated:         wfVersion.Deprecated,
+			DeprecationMessage: wfVersion.DeprecationMessage,
 		},
 		CanvasInfo: vo.CanvasInfo{
 			Canvas:          wfVersion.Canvas,
@@ -779,6 +829,99 @@ func (r *RepositoryImpl) GetVersion(ctx context.Context, id int64, version strin
 	}, true, nil
 }
 
+func (r *RepositoryImpl) DeprecateVersion(ctx context.Context, id int64, version string, message string) (err error) {
+	defer func() {
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrDatabaseError, err)
+		}
+	}()
+
+	result, err := r.query.WorkflowVersion.WithContext(ctx).
+		Where(r.query.WorkflowVersion.WorkflowID.Eq(id), r.query.WorkflowVersion.Version.Eq(version)).
+		UpdateColumnSimple(
+			r.query.WorkflowVersion.Deprecated.Value(true),
+			r.query.WorkflowVersion.DeprecationMessage.Value(message),
+		)
+	if err != nil {
+		return fmt.Errorf("failed to deprecate workflow version %s for ID %d: %w", version, id, err)
+	}
+	if result.RowsAffected == 0 {
+		return vo.WrapError(errno.ErrWorkflowNotFound,
+			fmt.Errorf("workflow version %s not found for ID %d", version, id),
+			errorx.KV("id", strconv.FormatInt(id, 10)))
+	}
+
+	return nil
+}
+
+func (r *RepositoryImpl) ListVersions(ctx context.Context, id int64) (_ []*vo.VersionMeta, err error) {
+	defer func() {
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrDatabaseError, err)
+		}
+	}()
+
+	versions, err := r.query.WorkflowVersion.WithContext(ctx).
+		Where(r.query.WorkflowVersion.WorkflowID.Eq(id)).
+		Order(r.query.WorkflowVersion.CreatedAt.Desc()).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow versions for ID %d: %w", id, err)
+	}
+
+	result := make([]*vo.VersionMeta, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, &vo.VersionMeta{
+			Version:            v.Version,
+			VersionDescription: v.VersionDescription,
+			VersionCreatedAt:   time.UnixMilli(v.CreatedAt),
+			VersionCreatorID:   v.CreatorID,
+			Deprecated:         v.Deprecated,
+			DeprecationMessage: v.DeprecationMessage,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *RepositoryImpl) ListVersionsWithCanvas(ctx context.Context, id int64) (_ []*vo.VersionInfo, err error) {
+	defer func() {
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrDatabaseError, err)
+		}
+	}()
+
+	versions, err := r.query.WorkflowVersion.WithContext(ctx).
+		Where(r.query.WorkflowVersion.WorkflowID.Eq(id)).
+		Order(r.query.WorkflowVersion.CreatedAt.Asc()).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow versions with canvas for ID %d: %w", id, err)
+	}
+
+	result := make([]*vo.VersionInfo, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, &vo.VersionInfo{
+			VersionMeta: &vo.VersionMeta{
+				Version:            v.Version,
+				VersionDescription: v.VersionDescription,
+				VersionCreatedAt:   time.UnixMilli(v.CreatedAt),
+				VersionCreatorID:   v.CreatorID,
+				Deprecated:         v.Deprecated,
+				DeprecationMessage: v.DeprecationMessage,
+			},
+			CanvasInfo: vo.CanvasInfo{
+				Canvas:          v.Canvas,
+				InputParamsStr:  v.InputParams,
+				OutputParamsStr: v.OutputParams,
+			},
+			CommitID: v.CommitID,
+		})
+	}
+
+	return result, nil
+}
+
 func (r *RepositoryImpl) GetVersionListByConnectorAndWorkflowID(ctx context.Context, connectorID, workflowID int64, limit int) (_ []string, err error) {
 	if limit <= 0 {
 		return nil, vo.WrapError(errno.ErrInvalidParameter, errors.New("limit must be greater than 0"))
@@ -935,6 +1078,10 @@ func (r *RepositoryImpl) MGetDrafts(ctx context.Context, policy *vo.MGetPolicy)
 		conditions = append(conditions, r.query.WorkflowMeta.Mode.Eq(int32(*q.Mode)))
 	}
 
+	if q.Tag != nil {
+		conditions = append(conditions, r.query.WorkflowMeta.Tag.Eq(int32(*q.Tag)))
+	}
+
 	type combinedDraft struct {
 		model.WorkflowDraft
 		Name          string `gorm:"column:name"`
@@ -1105,6 +1252,10 @@ func (r *RepositoryImpl) MGetLatestVersion(ctx context.Context, policy *vo.MGetP
 		conditions = append(conditions, r.query.WorkflowMeta.Mode.Eq(int32(*q.Mode)))
 	}
 
+	if q.Tag != nil {
+		conditions = append(conditions, r.query.WorkflowMeta.Tag.Eq(int32(*q.Tag)))
+	}
+
 	type combinedVersion struct {
 		model.WorkflowMeta
 		Version            string `gorm:"column:version"`             // release version
@@ -1333,6 +1484,10 @@ func (r *RepositoryImpl) MGetMetas(ctx context.Context, query *vo.MetaQuery) (
 		conditions = append(conditions, r.query.WorkflowMeta.Mode.Eq(int32(*query.Mode)))
 	}
 
+	if query.Tag != nil {
+		conditions = append(conditions, r.query.WorkflowMeta.Tag.Eq(int32(*query.Tag)))
+	}
+
 	var result []*model.WorkflowMeta
 
 	workflowMetaDo := r.query.WorkflowMeta.WithContext(ctx).Debug().Where(conditions...)
@@ -1394,6 +1549,8 @@ func (r *RepositoryImpl) GetLatestVersion(ctx context.Context, id int64) (*vo.Ve
 			VersionDescription: version.VersionDescription,
 			VersionCreatedAt:   time.UnixMilli(version.CreatedAt),
 			VersionCreatorID:   version.CreatorID,
+			Deprecated:         version.Deprecated,
+			DeprecationMessage: version.DeprecationMessage,
 		},
 		CanvasInfo: vo.CanvasInfo{
 			Canvas:          version.Canvas,
@@ -1441,6 +1598,95 @@ func (r *RepositoryImpl) CreateSnapshotIfNeeded(ctx context.Context, id int64, c
 	})
 }
 
+func (r *RepositoryImpl) SaveDraftSnapshot(ctx context.Context, id int64) (_ *vo.DraftSnapshotMeta, err error) {
+	defer func() {
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrDatabaseError, err)
+		}
+	}()
+
+	draft, err := r.query.WorkflowDraft.WithContext(ctx).Where(r.query.WorkflowDraft.ID.Eq(id)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, vo.WrapError(errno.ErrWorkflowNotFound,
+				fmt.Errorf("workflow draft not found for ID %d: %w", id, err),
+				errorx.KV("id", strconv.FormatInt(id, 10)))
+		}
+		return nil, err
+	}
+
+	newCommitID, err := r.GenID(ctx)
+	if err != nil {
+		return nil, vo.WrapError(errno.ErrIDGenError, err)
+	}
+	commitID := strconv.FormatInt(newCommitID, 10)
+
+	if err = r.query.WorkflowSnapshot.WithContext(ctx).Save(&model.WorkflowSnapshot{
+		// ID: auto_increment
+		WorkflowID:   id,
+		CommitID:     commitID,
+		Canvas:       draft.Canvas,
+		InputParams:  draft.InputParams,
+		OutputParams: draft.OutputParams,
+	}); err != nil {
+		return nil, err
+	}
+
+	saved, err := r.query.WorkflowSnapshot.WithContext(ctx).Where(
+		r.query.WorkflowSnapshot.WorkflowID.Eq(id),
+		r.query.WorkflowSnapshot.CommitID.Eq(commitID),
+	).First()
+	if err != nil {
+		return nil, err
+	}
+
+	stale, err := r.query.WorkflowSnapshot.WithContext(ctx).
+		Where(r.query.WorkflowSnapshot.WorkflowID.Eq(id)).
+		Order(r.query.WorkflowSnapshot.ID.Desc()).
+		Offset(vo.MaxDraftSnapshots).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	if len(stale) > 0 {
+		if _, err = r.query.WorkflowSnapshot.WithContext(ctx).Delete(stale...); err != nil {
+			return nil, err
+		}
+	}
+
+	return &vo.DraftSnapshotMeta{
+		CommitID:  saved.CommitID,
+		CreatedAt: time.UnixMilli(saved.CreatedAt),
+	}, nil
+}
+
+func (r *RepositoryImpl) ListDraftSnapshots(ctx context.Context, id int64) (_ []*vo.DraftSnapshotMeta, err error) {
+	defer func() {
+		if err != nil {
+			err = vo.WrapIfNeeded(errno.ErrDatabaseError, err)
+		}
+	}()
+
+	snapshots, err := r.query.WorkflowSnapshot.WithContext(ctx).
+		Where(r.query.WorkflowSnapshot.WorkflowID.Eq(id)).
+		Order(r.query.WorkflowSnapshot.ID.Desc()).
+		Limit(vo.MaxDraftSnapshots).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+
+	metas := make([]*vo.DraftSnapshotMeta, 0, len(snapshots))
+	for _, s := range snapshots {
+		metas = append(metas, &vo.DraftSnapshotMeta{
+			CommitID:  s.CommitID,
+			CreatedAt: time.UnixMilli(s.CreatedAt),
+		})
+	}
+
+	return metas, nil
+}
+
 func (r *RepositoryImpl) WorkflowAsTool(ctx context.Context, policy vo.GetPolicy, wfToolConfig vo.WorkflowToolConfig) (workflow.ToolFromWorkflow, error) {
 	var (
 		canvas               vo.Canvas
@@ -1539,6 +1785,7 @@ func (r *RepositoryImpl) WorkflowAsTool(ctx context.Context, policy vo.GetPolicy
 			wfEntity,
 			workflowSC,
 			r,
+			wfToolConfig.ResponseStyle,
 		), nil
 	}
 
@@ -1587,6 +1834,7 @@ func (r *RepositoryImpl) WorkflowAsTool(ctx context.Context, policy vo.GetPolicy
 		wfEntity,
 		workflowSC,
 		r,
+		wfToolConfig.ResponseStyle,
 	), nil
 }
 
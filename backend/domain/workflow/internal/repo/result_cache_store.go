@@ -0,0 +1,57 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+	"github.com/coze-dev/coze-studio/backend/infra/cache"
+	"github.com/coze-dev/coze-studio/backend/types/errno"
+)
+
+type resultCacheStoreImpl struct {
+	redis cache.Cmdable
+}
+
+const resultCacheKeyPattern = "workflow:result_cache:%s"
+
+// GetCachedResult returns the cached result for key, if any is present and has not expired.
+func (r *resultCacheStoreImpl) GetCachedResult(ctx context.Context, key string) (string, bool, error) {
+	result, err := r.redis.Get(ctx, fmt.Sprintf(resultCacheKeyPattern, key)).Result()
+	if err != nil {
+		if errors.Is(err, cache.Nil) {
+			return "", false, nil
+		}
+		return "", false, vo.WrapError(errno.ErrRedisError, fmt.Errorf("failed to read cached result for key %s: %w", key, err))
+	}
+
+	return result, true, nil
+}
+
+// SetCachedResult stores result under key for ttl. A ttl of zero or less means the entry never
+// expires.
+func (r *resultCacheStoreImpl) SetCachedResult(ctx context.Context, key string, result string, ttl time.Duration) error {
+	if err := r.redis.Set(ctx, fmt.Sprintf(resultCacheKeyPattern, key), result, ttl).Err(); err != nil {
+		return vo.WrapError(errno.ErrRedisError, fmt.Errorf("failed to cache result for key %s: %w", key, err))
+	}
+
+	return nil
+}
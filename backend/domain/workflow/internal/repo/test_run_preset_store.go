@@ -0,0 +1,108 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+	"github.com/coze-dev/coze-studio/backend/infra/cache"
+	"github.com/coze-dev/coze-studio/backend/pkg/sonic"
+	"github.com/coze-dev/coze-studio/backend/types/errno"
+)
+
+type testRunPresetStoreImpl struct {
+	redis cache.Cmdable
+}
+
+const (
+	testRunPresetsKeyPattern = "test_run_presets:%d:%d"
+	testRunPresetsExpiry     = 30 * 24 * time.Hour
+)
+
+type testRunPresetRecord struct {
+	Input     map[string]string `json:"input"`
+	CreatedAt int64             `json:"created_at"`
+}
+
+// SaveTestRunPreset creates or overwrites, by name, a named test-run input set for workflowID
+// and preset.UserID.
+func (t *testRunPresetStoreImpl) SaveTestRunPreset(ctx context.Context, preset *entity.TestRunPreset) error {
+	key := fmt.Sprintf(testRunPresetsKeyPattern, preset.WorkflowID, preset.UserID)
+
+	record := testRunPresetRecord{
+		Input:     preset.Input,
+		CreatedAt: preset.CreatedAt.Unix(),
+	}
+	b, err := sonic.Marshal(record)
+	if err != nil {
+		return vo.WrapError(errno.ErrSchemaConversionFail, fmt.Errorf("failed to marshal test run preset: %w", err))
+	}
+
+	if err := t.redis.HSet(ctx, key, preset.Name, string(b)).Err(); err != nil {
+		return vo.WrapError(errno.ErrRedisError, fmt.Errorf("failed to save test run preset %q for workflow %d: %w", preset.Name, preset.WorkflowID, err))
+	}
+
+	if err := t.redis.Expire(ctx, key, testRunPresetsExpiry).Err(); err != nil {
+		return vo.WrapError(errno.ErrRedisError, fmt.Errorf("failed to refresh test run presets ttl for workflow %d: %w", preset.WorkflowID, err))
+	}
+
+	return nil
+}
+
+// ListTestRunPresets returns all presets saved by userID for workflowID, in no particular order.
+func (t *testRunPresetStoreImpl) ListTestRunPresets(ctx context.Context, workflowID, userID int64) ([]*entity.TestRunPreset, error) {
+	key := fmt.Sprintf(testRunPresetsKeyPattern, workflowID, userID)
+
+	fields, err := t.redis.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, vo.WrapError(errno.ErrRedisError, fmt.Errorf("failed to list test run presets for workflow %d: %w", workflowID, err))
+	}
+
+	presets := make([]*entity.TestRunPreset, 0, len(fields))
+	for name, raw := range fields {
+		var record testRunPresetRecord
+		if err := sonic.Unmarshal([]byte(raw), &record); err != nil {
+			return nil, vo.WrapError(errno.ErrSchemaConversionFail, fmt.Errorf("failed to unmarshal test run preset %q: %w", name, err))
+		}
+
+		presets = append(presets, &entity.TestRunPreset{
+			WorkflowID: workflowID,
+			UserID:     userID,
+			Name:       name,
+			Input:      record.Input,
+			CreatedAt:  time.Unix(record.CreatedAt, 0),
+		})
+	}
+
+	return presets, nil
+}
+
+// DeleteTestRunPreset removes the named preset, if present. Deleting a preset that doesn't exist
+// is a no-op.
+func (t *testRunPresetStoreImpl) DeleteTestRunPreset(ctx context.Context, workflowID, userID int64, name string) error {
+	key := fmt.Sprintf(testRunPresetsKeyPattern, workflowID, userID)
+
+	if err := t.redis.HDel(ctx, key, name).Err(); err != nil {
+		return vo.WrapError(errno.ErrRedisError, fmt.Errorf("failed to delete test run preset %q for workflow %d: %w", name, workflowID, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
+	"github.com/coze-dev/coze-studio/backend/infra/cache/impl/redis"
+)
+
+func newTestRunPresetStore(t *testing.T) *testRunPresetStoreImpl {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	return &testRunPresetStoreImpl{redis: redis.NewWithAddrAndPassword(mr.Addr(), "")}
+}
+
+func TestTestRunPresetStore_SaveAndList(t *testing.T) {
+	store := newTestRunPresetStore(t)
+	ctx := context.Background()
+
+	assert.NoError(t, store.SaveTestRunPreset(ctx, &entity.TestRunPreset{
+		WorkflowID: 1,
+		UserID:     2,
+		Name:       "happy_path",
+		Input:      map[string]string{"query": "hello"},
+		CreatedAt:  time.Now(),
+	}))
+
+	presets, err := store.ListTestRunPresets(ctx, 1, 2)
+	assert.NoError(t, err)
+	assert.Len(t, presets, 1)
+	assert.Equal(t, "happy_path", presets[0].Name)
+	assert.Equal(t, "hello", presets[0].Input["query"])
+}
+
+func TestTestRunPresetStore_SaveOverwritesSameName(t *testing.T) {
+	store := newTestRunPresetStore(t)
+	ctx := context.Background()
+
+	for _, q := range []string{"first", "second"} {
+		assert.NoError(t, store.SaveTestRunPreset(ctx, &entity.TestRunPreset{
+			WorkflowID: 1,
+			UserID:     2,
+			Name:       "preset",
+			Input:      map[string]string{"query": q},
+			CreatedAt:  time.Now(),
+		}))
+	}
+
+	presets, err := store.ListTestRunPresets(ctx, 1, 2)
+	assert.NoError(t, err)
+	assert.Len(t, presets, 1)
+	assert.Equal(t, "second", presets[0].Input["query"])
+}
+
+func TestTestRunPresetStore_Delete(t *testing.T) {
+	store := newTestRunPresetStore(t)
+	ctx := context.Background()
+
+	assert.NoError(t, store.SaveTestRunPreset(ctx, &entity.TestRunPreset{
+		WorkflowID: 1,
+		UserID:     2,
+		Name:       "preset",
+		Input:      map[string]string{"query": "hello"},
+		CreatedAt:  time.Now(),
+	}))
+
+	assert.NoError(t, store.DeleteTestRunPreset(ctx, 1, 2, "preset"))
+
+	presets, err := store.ListTestRunPresets(ctx, 1, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, presets)
+}
+
+func TestTestRunPresetStore_ListEmpty(t *testing.T) {
+	store := newTestRunPresetStore(t)
+	ctx := context.Background()
+
+	presets, err := store.ListTestRunPresets(ctx, 1, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, presets)
+}
@@ -20,6 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/coze-dev/coze-studio/backend/types/consts"
@@ -37,6 +40,7 @@ import (
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/compose"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/execute"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/nodes"
+	"github.com/coze-dev/coze-studio/backend/infra/metrics"
 	"github.com/coze-dev/coze-studio/backend/pkg/errorx"
 	"github.com/coze-dev/coze-studio/backend/pkg/lang/ptr"
 	"github.com/coze-dev/coze-studio/backend/pkg/lang/slices"
@@ -91,6 +95,7 @@ func (i *impl) SyncExecute(ctx context.Context, config workflowModel.ExecuteConf
 	})
 	var wfOpts []compose.WorkflowOption
 	wfOpts = append(wfOpts, compose.WithIDAsName(wfEntity.ID))
+	wfOpts = append(wfOpts, compose.WithBreakpoints(config.Breakpoints))
 	if s := execute.GetStaticConfig(); s != nil && s.MaxNodeCountPerWorkflow > 0 {
 		wfOpts = append(wfOpts, compose.WithMaxNodeCount(s.MaxNodeCountPerWorkflow))
 	}
@@ -178,6 +183,8 @@ func (i *impl) SyncExecute(ctx context.Context, config workflowModel.ExecuteConf
 		failReason = ptr.Of(lastEvent.Err.Error())
 	}
 
+	metrics.WorkflowExecutionsTotal.WithLabelValues(string(config.Mode), strconv.Itoa(int(status))).Inc()
+
 	return &entity.WorkflowExecution{
 		ID:            executeID,
 		WorkflowID:    wfEntity.ID,
@@ -248,6 +255,7 @@ func (i *impl) AsyncExecute(ctx context.Context, config workflowModel.ExecuteCon
 
 	var wfOpts []compose.WorkflowOption
 	wfOpts = append(wfOpts, compose.WithIDAsName(wfEntity.ID))
+	wfOpts = append(wfOpts, compose.WithBreakpoints(config.Breakpoints))
 	if s := execute.GetStaticConfig(); s != nil && s.MaxNodeCountPerWorkflow > 0 {
 		wfOpts = append(wfOpts, compose.WithMaxNodeCount(s.MaxNodeCountPerWorkflow))
 	}
@@ -300,6 +308,8 @@ func (i *impl) AsyncExecute(ctx context.Context, config workflowModel.ExecuteCon
 
 	wf.AsyncRun(cancelCtx, convertedInput, opts...)
 
+	metrics.WorkflowExecutionsTotal.WithLabelValues(string(config.Mode), "started").Inc()
+
 	return executeID, nil
 }
 
@@ -504,6 +514,7 @@ func (i *impl) StreamExecute(ctx context.Context, config workflowModel.ExecuteCo
 	var wfOpts []compose.WorkflowOption
 
 	wfOpts = append(wfOpts, compose.WithIDAsName(wfEntity.ID))
+	wfOpts = append(wfOpts, compose.WithBreakpoints(config.Breakpoints))
 	if s := execute.GetStaticConfig(); s != nil && s.MaxNodeCountPerWorkflow > 0 {
 		wfOpts = append(wfOpts, compose.WithMaxNodeCount(s.MaxNodeCountPerWorkflow))
 	}
@@ -713,6 +724,26 @@ func (i *impl) GetLatestTestRunInput(ctx context.Context, wfID int64, userID int
 	return nodeExe, true, nil
 }
 
+func (i *impl) GetLatestSuccessfulExecution(ctx context.Context, wfID int64, userID int64) (*entity.WorkflowExecution, bool, error) {
+	exe, found, err := i.repo.GetLatestWorkflowExecutionByStatus(ctx, wfID, userID, entity.WorkflowSuccess)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[GetLatestSuccessfulExecution] failed to get workflow execution, wfID: %d, err: %v", wfID, err)
+		return nil, false, nil
+	}
+
+	return exe, found, nil
+}
+
+func (i *impl) GetLatestFailedExecution(ctx context.Context, wfID int64, userID int64) (*entity.WorkflowExecution, bool, error) {
+	exe, found, err := i.repo.GetLatestWorkflowExecutionByStatus(ctx, wfID, userID, entity.WorkflowFailed)
+	if err != nil {
+		logs.CtxErrorf(ctx, "[GetLatestFailedExecution] failed to get workflow execution, wfID: %d, err: %v", wfID, err)
+		return nil, false, nil
+	}
+
+	return exe, found, nil
+}
+
 func (i *impl) GetLatestNodeDebugInput(ctx context.Context, wfID int64, nodeID string, userID int64) (
 	*entity.NodeExecution, *entity.NodeExecution, bool, error) {
 	exeID, err := i.repo.GetNodeDebugLatestExeID(ctx, wfID, nodeID, userID)
@@ -736,6 +767,86 @@ func (i *impl) GetLatestNodeDebugInput(ctx context.Context, wfID int64, nodeID s
 	return nodeExe, innerExe, true, nil
 }
 
+// InferLatestTestRunOutputSchema inspects the end node's input from the latest successful test
+// run and infers a JSON schema from the actual values, as a []*vo.Variable tree. This gives
+// UseAnswerContent workflows (whose declared output is just the rendered answer string) a real
+// structured shape to show users, derived from what the workflow actually produced.
+func (i *impl) InferLatestTestRunOutputSchema(ctx context.Context, wfID int64, userID int64) ([]*vo.Variable, error) {
+	exeID, err := i.repo.GetTestRunLatestExeID(ctx, wfID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if exeID == 0 {
+		return nil, fmt.Errorf("no test run found for workflow %d", wfID)
+	}
+
+	wfExe, exist, err := i.repo.GetWorkflowExecution(ctx, exeID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || wfExe.Status != entity.WorkflowSuccess {
+		return nil, fmt.Errorf("latest test run for workflow %d did not succeed", wfID)
+	}
+
+	nodeExe, exist, err := i.repo.GetNodeExecution(ctx, exeID, entity.ExitNodeKey)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || nodeExe.Input == nil {
+		return nil, fmt.Errorf("end node input not found for workflow %d's latest test run", wfID)
+	}
+
+	var output map[string]any
+	if err := sonic.UnmarshalString(*nodeExe.Input, &output); err != nil {
+		return nil, vo.WrapError(errno.ErrSerializationDeserializationFail, err)
+	}
+
+	return inferVariablesFromOutput(output), nil
+}
+
+func inferVariablesFromOutput(output map[string]any) []*vo.Variable {
+	names := make([]string, 0, len(output))
+	for name := range output {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	vars := make([]*vo.Variable, 0, len(output))
+	for _, name := range names {
+		vars = append(vars, inferVariableFromValue(name, output[name]))
+	}
+	return vars
+}
+
+func inferVariableFromValue(name string, value any) *vo.Variable {
+	v := &vo.Variable{Name: name}
+	switch val := value.(type) {
+	case string:
+		v.Type = vo.VariableTypeString
+	case bool:
+		v.Type = vo.VariableTypeBoolean
+	case float64:
+		if val == math.Trunc(val) {
+			v.Type = vo.VariableTypeInteger
+		} else {
+			v.Type = vo.VariableTypeFloat
+		}
+	case []any:
+		v.Type = vo.VariableTypeList
+		if len(val) > 0 {
+			v.Schema = inferVariableFromValue(name, val[0])
+		} else {
+			v.Schema = &vo.Variable{Name: name, Type: vo.VariableTypeString}
+		}
+	case map[string]any:
+		v.Type = vo.VariableTypeObject
+		v.Schema = inferVariablesFromOutput(val)
+	default:
+		v.Type = vo.VariableTypeString
+	}
+	return v
+}
+
 func mergeCompositeInnerNodes(nodeExes map[int]*entity.NodeExecution, maxIndex int) *entity.NodeExecution {
 	var groupNodeExe *entity.NodeExecution
 	for _, v := range nodeExes {
@@ -886,6 +997,7 @@ func (i *impl) AsyncResume(ctx context.Context, req *entity.ResumeRequest, confi
 
 	var wfOpts []compose.WorkflowOption
 	wfOpts = append(wfOpts, compose.WithIDAsName(wfExe.WorkflowID))
+	wfOpts = append(wfOpts, compose.WithBreakpoints(config.Breakpoints))
 	if s := execute.GetStaticConfig(); s != nil && s.MaxNodeCountPerWorkflow > 0 {
 		wfOpts = append(wfOpts, compose.WithMaxNodeCount(s.MaxNodeCountPerWorkflow))
 	}
@@ -960,6 +1072,7 @@ func (i *impl) StreamResume(ctx context.Context, req *entity.ResumeRequest, conf
 
 	var wfOpts []compose.WorkflowOption
 	wfOpts = append(wfOpts, compose.WithIDAsName(wfExe.WorkflowID))
+	wfOpts = append(wfOpts, compose.WithBreakpoints(config.Breakpoints))
 	if s := execute.GetStaticConfig(); s != nil && s.MaxNodeCountPerWorkflow > 0 {
 		wfOpts = append(wfOpts, compose.WithMaxNodeCount(s.MaxNodeCountPerWorkflow))
 	}
@@ -1042,6 +1155,10 @@ func (i *impl) Cancel(ctx context.Context, wfExeID int64, wfID, spaceID int64) e
 	return i.repo.SetWorkflowCancelFlag(ctx, wfExeID)
 }
 
+func (i *impl) GetVariantStats(ctx context.Context, wfID int64, from, to time.Time) ([]*entity.VariantStats, error) {
+	return i.repo.GetVariantStats(ctx, wfID, from, to)
+}
+
 func (i *impl) checkApplicationWorkflowReleaseVersion(ctx context.Context, appID, connectorID, workflowID int64, version string) error {
 	ok, err := i.repo.IsApplicationConnectorWorkflowVersion(ctx, connectorID, workflowID, version)
 	if err != nil {
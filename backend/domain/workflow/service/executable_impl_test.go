@@ -195,6 +195,47 @@ func TestImpl_handleHistory(t *testing.T) {
 	}
 }
 
+func TestImpl_Cancel_Interrupted(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t, gomock.WithOverridableExpectations())
+	defer ctrl.Finish()
+
+	mockRepo := mock_workflow.NewMockRepository(ctrl)
+	testImpl := &impl{repo: mockRepo}
+
+	wfExeID, wfID, spaceID := int64(1), int64(2), int64(3)
+
+	mockRepo.EXPECT().GetWorkflowExecution(gomock.Any(), wfExeID).Return(&entity.WorkflowExecution{
+		ID:              wfExeID,
+		RootExecutionID: wfExeID,
+		WorkflowID:      wfID,
+		SpaceID:         spaceID,
+		Status:          entity.WorkflowInterrupted,
+	}, true, nil)
+	mockRepo.EXPECT().UpdateWorkflowExecution(gomock.Any(), &entity.WorkflowExecution{
+		ID:     wfExeID,
+		Status: entity.WorkflowCancel,
+	}, []entity.WorkflowExecuteStatus{entity.WorkflowInterrupted}).Return(int64(1), entity.WorkflowExecuteStatus(0), nil)
+	mockRepo.EXPECT().CancelAllRunningNodes(gomock.Any(), wfExeID).Return(nil)
+	mockRepo.EXPECT().SetWorkflowCancelFlag(gomock.Any(), wfExeID).Return(nil)
+
+	err := testImpl.Cancel(ctx, wfExeID, wfID, spaceID)
+	assert.NoError(t, err)
+
+	// a later resume attempt must see the execution as already terminated, not interrupted
+	mockRepo.EXPECT().GetWorkflowExecution(gomock.Any(), wfExeID).Return(&entity.WorkflowExecution{
+		ID:              wfExeID,
+		RootExecutionID: wfExeID,
+		WorkflowID:      wfID,
+		SpaceID:         spaceID,
+		Status:          entity.WorkflowCancel,
+	}, true, nil)
+
+	err = testImpl.AsyncResume(ctx, &entity.ResumeRequest{ExecuteID: wfExeID}, workflowModel.ExecuteConfig{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not interrupted")
+}
+
 func TestImpl_prefetchChatHistory(t *testing.T) {
 	ctx := context.Background()
 	ctrl := gomock.NewController(t, gomock.WithOverridableExpectations())
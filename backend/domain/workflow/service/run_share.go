@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+	"github.com/coze-dev/coze-studio/backend/pkg/envkey"
+)
+
+// runShareSecret signs run-share tokens minted by newRunShareToken. There is deliberately no
+// built-in default: a run-share token waives space-membership checks, so a hardcoded fallback
+// would mean every self-hosted deployment that didn't set this shares the same signing key,
+// letting anyone who has read this source forge a token for an arbitrary execution. Operators
+// must set it themselves; until they do, minting and verifying tokens both fail closed.
+var runShareSecret = envkey.GetString("WORKFLOW_RUN_SHARE_SECRET")
+
+// newRunShareToken signs claims into an opaque, URL-safe token.
+func newRunShareToken(claims vo.RunShareClaims) (string, error) {
+	if runShareSecret == "" {
+		return "", fmt.Errorf("WORKFLOW_RUN_SHARE_SECRET is not configured; run-share links are disabled")
+	}
+
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	h := hmac.New(sha256.New, []byte(runShareSecret))
+	h.Write(data)
+	signature := h.Sum(nil)
+
+	return base64.RawURLEncoding.EncodeToString(append(data, signature...)), nil
+}
+
+// parseRunShareToken verifies token's signature and expiry and returns the claims it carries.
+func parseRunShareToken(token string) (*vo.RunShareClaims, error) {
+	if runShareSecret == "" {
+		return nil, fmt.Errorf("WORKFLOW_RUN_SHARE_SECRET is not configured; run-share links are disabled")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid run share token: %w", err)
+	}
+
+	if len(data) < sha256.Size {
+		return nil, fmt.Errorf("run share token too short")
+	}
+
+	claimsData := data[:len(data)-sha256.Size]
+	signature := data[len(data)-sha256.Size:]
+
+	h := hmac.New(sha256.New, []byte(runShareSecret))
+	h.Write(claimsData)
+	if !hmac.Equal(signature, h.Sum(nil)) {
+		return nil, fmt.Errorf("invalid run share token signature")
+	}
+
+	var claims vo.RunShareClaims
+	if err := json.Unmarshal(claimsData, &claims); err != nil {
+		return nil, fmt.Errorf("invalid run share token claims: %w", err)
+	}
+
+	if time.Now().After(claims.ExpiresAt) {
+		return nil, fmt.Errorf("run share token expired")
+	}
+
+	return &claims, nil
+}
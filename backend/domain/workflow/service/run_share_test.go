@@ -0,0 +1,107 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
+)
+
+func withRunShareSecret(t *testing.T, secret string) {
+	t.Helper()
+	old := runShareSecret
+	runShareSecret = secret
+	t.Cleanup(func() { runShareSecret = old })
+}
+
+func TestNewAndParseRunShareToken_RoundTrip(t *testing.T) {
+	withRunShareSecret(t, "test-secret")
+
+	claims := vo.RunShareClaims{
+		WorkflowID: 123,
+		ExecuteID:  456,
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}
+
+	token, err := newRunShareToken(claims)
+	require.NoError(t, err)
+
+	got, err := parseRunShareToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, claims.WorkflowID, got.WorkflowID)
+	assert.Equal(t, claims.ExecuteID, got.ExecuteID)
+	assert.True(t, claims.ExpiresAt.Equal(got.ExpiresAt))
+}
+
+func TestNewRunShareToken_FailsClosedWhenSecretUnset(t *testing.T) {
+	withRunShareSecret(t, "")
+
+	_, err := newRunShareToken(vo.RunShareClaims{WorkflowID: 1, ExecuteID: 2, ExpiresAt: time.Now().Add(time.Hour)})
+	assert.Error(t, err)
+}
+
+func TestParseRunShareToken_FailsClosedWhenSecretUnset(t *testing.T) {
+	withRunShareSecret(t, "test-secret")
+	token, err := newRunShareToken(vo.RunShareClaims{WorkflowID: 1, ExecuteID: 2, ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	withRunShareSecret(t, "")
+	_, err = parseRunShareToken(token)
+	assert.Error(t, err)
+}
+
+func TestParseRunShareToken_RejectsTamperedToken(t *testing.T) {
+	withRunShareSecret(t, "test-secret")
+
+	token, err := newRunShareToken(vo.RunShareClaims{WorkflowID: 1, ExecuteID: 2, ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	_, err = parseRunShareToken(token + "x")
+	assert.Error(t, err)
+}
+
+func TestParseRunShareToken_RejectsTokenSignedWithDifferentSecret(t *testing.T) {
+	withRunShareSecret(t, "secret-a")
+	token, err := newRunShareToken(vo.RunShareClaims{WorkflowID: 1, ExecuteID: 2, ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	withRunShareSecret(t, "secret-b")
+	_, err = parseRunShareToken(token)
+	assert.Error(t, err)
+}
+
+func TestParseRunShareToken_RejectsExpiredToken(t *testing.T) {
+	withRunShareSecret(t, "test-secret")
+
+	token, err := newRunShareToken(vo.RunShareClaims{WorkflowID: 1, ExecuteID: 2, ExpiresAt: time.Now().Add(-time.Minute)})
+	require.NoError(t, err)
+
+	_, err = parseRunShareToken(token)
+	assert.Error(t, err)
+}
+
+func TestParseRunShareToken_RejectsGarbageToken(t *testing.T) {
+	withRunShareSecret(t, "test-secret")
+
+	_, err := parseRunShareToken("not-a-valid-token!!")
+	assert.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	einoCompose "github.com/cloudwego/eino/compose"
 	"github.com/spf13/cast"
@@ -37,6 +38,8 @@ import (
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/entity/vo"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/canvas/adaptor"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/canvas/convert"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/canvas/migration"
+	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/canvas/validate"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/repo"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/schema"
 	"github.com/coze-dev/coze-studio/backend/infra/cache"
@@ -163,6 +166,73 @@ func (i *impl) Save(ctx context.Context, id int64, schema string) (err error) {
 	})
 }
 
+func (i *impl) SaveDraftSnapshot(ctx context.Context, id int64) (*vo.DraftSnapshotMeta, error) {
+	return i.repo.SaveDraftSnapshot(ctx, id)
+}
+
+func (i *impl) ListDraftSnapshots(ctx context.Context, id int64) ([]*vo.DraftSnapshotMeta, error) {
+	return i.repo.ListDraftSnapshots(ctx, id)
+}
+
+func (i *impl) RestoreDraftSnapshot(ctx context.Context, id int64, commitID string) error {
+	snapshot, err := i.repo.DraftV2(ctx, id, commitID)
+	if err != nil {
+		return err
+	}
+
+	return i.Save(ctx, id, snapshot.Canvas)
+}
+
+func (i *impl) AcquireEditLock(ctx context.Context, workflowID, holderID int64) (bool, error) {
+	return i.repo.AcquireEditLock(ctx, workflowID, holderID, repo.WorkflowEditLockTTL)
+}
+
+func (i *impl) ReleaseEditLock(ctx context.Context, workflowID, holderID int64) error {
+	return i.repo.ReleaseEditLock(ctx, workflowID, holderID)
+}
+
+func (i *impl) GetEditLock(ctx context.Context, workflowID int64) (*entity.EditLock, bool, error) {
+	return i.repo.GetEditLock(ctx, workflowID)
+}
+
+func (i *impl) GetCachedOpenAPIResult(ctx context.Context, key string) (string, bool, error) {
+	return i.repo.GetCachedResult(ctx, key)
+}
+
+func (i *impl) CacheOpenAPIResult(ctx context.Context, key, result string, ttl time.Duration) error {
+	return i.repo.SetCachedResult(ctx, key, result, ttl)
+}
+
+func (i *impl) CreateRunShareToken(_ context.Context, workflowID, executeID int64, ttl time.Duration) (string, error) {
+	return newRunShareToken(vo.RunShareClaims{
+		WorkflowID: workflowID,
+		ExecuteID:  executeID,
+		ExpiresAt:  time.Now().Add(ttl),
+	})
+}
+
+func (i *impl) ParseRunShareToken(_ context.Context, token string) (*vo.RunShareClaims, error) {
+	return parseRunShareToken(token)
+}
+
+func (i *impl) SaveTestRunPreset(ctx context.Context, id, userID int64, name string, input map[string]string) error {
+	return i.repo.SaveTestRunPreset(ctx, &entity.TestRunPreset{
+		WorkflowID: id,
+		UserID:     userID,
+		Name:       name,
+		Input:      input,
+		CreatedAt:  time.Now(),
+	})
+}
+
+func (i *impl) ListTestRunPresets(ctx context.Context, id, userID int64) ([]*entity.TestRunPreset, error) {
+	return i.repo.ListTestRunPresets(ctx, id, userID)
+}
+
+func (i *impl) DeleteTestRunPreset(ctx context.Context, id, userID int64, name string) error {
+	return i.repo.DeleteTestRunPreset(ctx, id, userID, name)
+}
+
 func extractInputsAndOutputsNamedInfoList(c *vo.Canvas) (inputs []*vo.NamedTypeInfo, outputs []*vo.NamedTypeInfo) {
 	defer func() {
 		if err := recover(); err != nil {
@@ -283,6 +353,99 @@ func (i *impl) GetWorkflowReference(ctx context.Context, id int64) (map[int64]*v
 	return ret, nil
 }
 
+func (i *impl) FindWorkflowsUsingPlugin(ctx context.Context, spaceID, pluginID int64) ([]*vo.Meta, error) {
+	workflows, _, err := i.repo.MGetDrafts(ctx, &vo.MGetPolicy{MetaQuery: vo.MetaQuery{SpaceID: &spaceID}})
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]*vo.Meta, 0)
+	for _, wf := range workflows {
+		if wf.CanvasInfo == nil || len(wf.CanvasInfo.Canvas) == 0 {
+			continue
+		}
+
+		deps, err := i.GetWorkflowDependenceResource(ctx, wf.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(deps.PluginIDs, pluginID) {
+			usages = append(usages, wf.Meta)
+		}
+	}
+
+	return usages, nil
+}
+
+func (i *impl) FindWorkflowsUsingKnowledge(ctx context.Context, spaceID, knowledgeID int64, page *vo.Page) ([]*vo.Meta, int64, error) {
+	workflows, total, err := i.repo.MGetDrafts(ctx, &vo.MGetPolicy{MetaQuery: vo.MetaQuery{SpaceID: &spaceID, Page: page}})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	matches := make([]*vo.Meta, 0)
+	for _, wf := range workflows {
+		if wf.CanvasInfo == nil || len(wf.CanvasInfo.Canvas) == 0 {
+			continue
+		}
+
+		deps, err := i.GetWorkflowDependenceResource(ctx, wf.ID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if slices.Contains(deps.KnowledgeIDs, knowledgeID) {
+			matches = append(matches, wf.Meta)
+		}
+	}
+
+	return matches, total, nil
+}
+
+func (i *impl) FindWorkflowsUsingDatabase(ctx context.Context, spaceID, databaseID int64) ([]*vo.DatabaseUsage, error) {
+	workflows, _, err := i.repo.MGetDrafts(ctx, &vo.MGetPolicy{MetaQuery: vo.MetaQuery{SpaceID: &spaceID}})
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]*vo.DatabaseUsage, 0)
+	for _, wf := range workflows {
+		if wf.CanvasInfo == nil || len(wf.CanvasInfo.Canvas) == 0 {
+			continue
+		}
+
+		c := &vo.Canvas{}
+		if err = sonic.UnmarshalString(wf.CanvasInfo.Canvas, c); err != nil {
+			return nil, vo.WrapError(errno.ErrSchemaConversionFail, err)
+		}
+
+		for _, n := range c.Nodes {
+			if !entity.NodeMetaByNodeType(entity.IDStrToNodeType(n.Type)).UseDatabase {
+				continue
+			}
+
+			for _, d := range n.Data.Inputs.DatabaseInfoList {
+				dsID, pErr := strconv.ParseInt(d.DatabaseInfoID, 10, 64)
+				if pErr != nil {
+					return nil, pErr
+				}
+
+				if dsID == databaseID {
+					usages = append(usages, &vo.DatabaseUsage{
+						WorkflowID: wf.ID,
+						NodeID:     n.ID,
+						NodeName:   n.Data.Meta.Title,
+					})
+					break
+				}
+			}
+		}
+	}
+
+	return usages, nil
+}
+
 type workflowIdentity struct {
 	ID      string `json:"id"`
 	Version string `json:"version"`
@@ -319,10 +482,15 @@ func (i *impl) ValidateTree(ctx context.Context, id int64, validateConfig vo.Val
 	}
 
 	if len(issues) > 0 {
-		wfValidateInfos = append(wfValidateInfos, &cloudworkflow.ValidateTreeInfo{
+		errData := toValidateErrorData(ctx, issues)
+		info := &cloudworkflow.ValidateTreeInfo{
 			WorkflowID: strconv.FormatInt(id, 10),
-			Errors:     toValidateErrorData(issues),
-		})
+			Errors:     errData,
+		}
+		if validateConfig.AnnotateByElement {
+			info.NodeAnnotations, info.EdgeAnnotations = groupValidateErrorsByElement(errData)
+		}
+		wfValidateInfos = append(wfValidateInfos, info)
 	}
 
 	c := &vo.Canvas{}
@@ -366,11 +534,16 @@ func (i *impl) ValidateTree(ctx context.Context, id int64, validateConfig vo.Val
 			}
 
 			if len(issues) > 0 {
-				wfValidateInfos = append(wfValidateInfos, &cloudworkflow.ValidateTreeInfo{
+				errData := toValidateErrorData(ctx, issues)
+				info := &cloudworkflow.ValidateTreeInfo{
 					WorkflowID: strconv.FormatInt(wf.ID, 10),
 					Name:       wf.Name,
-					Errors:     toValidateErrorData(issues),
-				})
+					Errors:     errData,
+				}
+				if validateConfig.AnnotateByElement {
+					info.NodeAnnotations, info.EdgeAnnotations = groupValidateErrorsByElement(errData)
+				}
+				wfValidateInfos = append(wfValidateInfos, info)
 			}
 		}
 	}
@@ -378,6 +551,19 @@ func (i *impl) ValidateTree(ctx context.Context, id int64, validateConfig vo.Val
 	return wfValidateInfos, err
 }
 
+func (i *impl) ValidateNode(ctx context.Context, nodeType entity.NodeType, nodeConfig string) ([]*cloudworkflow.ValidateErrorData, error) {
+	issues, err := validate.ValidateNode(ctx, nodeType, nodeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return toValidateErrorData(ctx, issues), nil
+}
+
+func (i *impl) MigrateCanvasSchema(ctx context.Context, canvasSchema string) (string, bool, error) {
+	return migration.Migrate(canvasSchema)
+}
+
 func (i *impl) QueryNodeProperties(ctx context.Context, wfID int64) (map[string]*vo.NodeProperty, error) {
 	draftInfo, err := i.repo.DraftV2(ctx, wfID, "")
 	if err != nil {
@@ -403,6 +589,252 @@ func (i *impl) QueryNodeProperties(ctx context.Context, wfID int64) (map[string]
 	return nodePropertyMap, nil
 }
 
+func (i *impl) GetWorkflowComplexity(ctx context.Context, workflowID int64) (*vo.WorkflowComplexity, error) {
+	draftInfo, err := i.repo.DraftV2(ctx, workflowID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	canvasSchema := draftInfo.Canvas
+	if len(canvasSchema) == 0 {
+		return nil, fmt.Errorf("no canvas schema")
+	}
+
+	canvas := &vo.Canvas{}
+	if err = sonic.UnmarshalString(canvasSchema, canvas); err != nil {
+		return nil, vo.WrapError(errno.ErrSerializationDeserializationFail, err)
+	}
+	canvas.Nodes, canvas.Edges = adaptor.PruneIsolatedNodes(canvas.Nodes, canvas.Edges, nil)
+
+	nodeCount, branchingDepth, externalRefCount := measureCanvasComplexity(canvas.Nodes)
+
+	subWorkflowDepth, err := i.measureSubWorkflowDepth(ctx, canvas.Nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	complexity := &vo.WorkflowComplexity{
+		NodeCount:              nodeCount,
+		BranchingDepth:         branchingDepth,
+		SubWorkflowDepth:       subWorkflowDepth,
+		ExternalReferenceCount: externalRefCount,
+	}
+	complexity.Score = complexity.NodeCount + 5*complexity.BranchingDepth + 5*complexity.SubWorkflowDepth + 2*complexity.ExternalReferenceCount
+
+	return complexity, nil
+}
+
+// measureCanvasComplexity walks nodes, returning the total node count (including nodes nested
+// inside composite nodes), the deepest nesting of composite nodes (batch/loop), and the number of
+// plugin/knowledge/database references found. It tolerates node configurations that don't fully
+// resolve (e.g. a missing ID param), since an exact count isn't worth failing the whole score for.
+func measureCanvasComplexity(nodes []*vo.Node) (nodeCount, branchingDepth, externalRefCount int) {
+	for _, node := range nodes {
+		nodeCount++
+
+		if node.Data != nil && node.Data.Inputs != nil {
+			meta := entity.NodeMetaByNodeType(entity.IDStrToNodeType(node.Type))
+			if meta != nil {
+				switch {
+				case meta.UsePlugin:
+					apiParams := slices.ToMap(node.Data.Inputs.APIParams, func(e *vo.Param) (string, *vo.Param) {
+						return e.Name, e
+					})
+					if _, ok := apiParams["pluginID"]; ok {
+						externalRefCount++
+					}
+				case meta.UseKnowledge:
+					if len(node.Data.Inputs.DatasetParam) > 0 && node.Data.Inputs.DatasetParam[0].Input != nil {
+						if ids, ok := node.Data.Inputs.DatasetParam[0].Input.Value.Content.([]any); ok {
+							externalRefCount += len(ids)
+						}
+					}
+				case meta.UseDatabase:
+					externalRefCount += len(node.Data.Inputs.DatabaseInfoList)
+				}
+			}
+		}
+
+		if len(node.Blocks) == 0 {
+			continue
+		}
+
+		blockNodeCount, blockBranchingDepth, blockExternalRefCount := measureCanvasComplexity(node.Blocks)
+		nodeCount += blockNodeCount
+		externalRefCount += blockExternalRefCount
+		if blockBranchingDepth+1 > branchingDepth {
+			branchingDepth = blockBranchingDepth + 1
+		}
+	}
+
+	return nodeCount, branchingDepth, externalRefCount
+}
+
+// measureSubWorkflowDepth returns the deepest chain of sub-workflow-calls-sub-workflow reachable
+// from nodes, mirroring collectNodePropertyMap's recursion into each NodeTypeSubWorkflow node's
+// own canvas.
+func (i *impl) measureSubWorkflowDepth(ctx context.Context, nodes []*vo.Node) (int, error) {
+	depth := 0
+	for _, node := range nodes {
+		if node.Type == entity.NodeTypeSubWorkflow.IDStr() {
+			wid, err := strconv.ParseInt(node.Data.Inputs.WorkflowID, 10, 64)
+			if err != nil {
+				return 0, vo.WrapError(errno.ErrSchemaConversionFail, err)
+			}
+
+			var canvasSchema string
+			if node.Data.Inputs.WorkflowVersion != "" {
+				versionInfo, existed, err := i.repo.GetVersion(ctx, wid, node.Data.Inputs.WorkflowVersion)
+				if err != nil {
+					return 0, err
+				}
+				if !existed {
+					return 0, vo.WrapError(errno.ErrWorkflowNotFound, fmt.Errorf("workflow version %s not found for ID %d", node.Data.Inputs.WorkflowVersion, wid), errorx.KV("id", strconv.FormatInt(wid, 10)))
+				}
+				canvasSchema = versionInfo.Canvas
+			} else {
+				draftInfo, err := i.repo.DraftV2(ctx, wid, "")
+				if err != nil {
+					return 0, err
+				}
+				canvasSchema = draftInfo.Canvas
+			}
+
+			if len(canvasSchema) == 0 {
+				return 0, fmt.Errorf("workflow id %v ,not get canvas schema, version %v", wid, node.Data.Inputs.WorkflowVersion)
+			}
+
+			c := &vo.Canvas{}
+			if err = sonic.UnmarshalString(canvasSchema, c); err != nil {
+				return 0, vo.WrapError(errno.ErrSchemaConversionFail, err)
+			}
+
+			subDepth, err := i.measureSubWorkflowDepth(ctx, c.Nodes)
+			if err != nil {
+				return 0, err
+			}
+			if subDepth+1 > depth {
+				depth = subDepth + 1
+			}
+			continue
+		}
+
+		if len(node.Blocks) == 0 {
+			continue
+		}
+		blockDepth, err := i.measureSubWorkflowDepth(ctx, node.Blocks)
+		if err != nil {
+			return 0, err
+		}
+		if blockDepth > depth {
+			depth = blockDepth
+		}
+	}
+
+	return depth, nil
+}
+
+// GenerateInputExample builds a sample JSON request body for a workflow's draft input
+// parameters, so callers (e.g. the frontend's "copy as cURL"/API doc view) can show users
+// what a valid input looks like without having to fill in a test run first.
+func (i *impl) GenerateInputExample(ctx context.Context, id int64) (string, error) {
+	wf, err := i.Get(ctx, &vo.GetPolicy{ID: id, QType: workflowModel.FromDraft})
+	if err != nil {
+		return "", err
+	}
+
+	example := make(map[string]any, len(wf.InputParams))
+	for _, param := range wf.InputParams {
+		v, err := param.ToVariable()
+		if err != nil {
+			return "", vo.WrapError(errno.ErrSchemaConversionFail, err)
+		}
+		example[v.Name] = v.ExampleValue()
+	}
+
+	exampleStr, err := sonic.MarshalString(example)
+	if err != nil {
+		return "", vo.WrapError(errno.ErrSerializationDeserializationFail, err)
+	}
+
+	return exampleStr, nil
+}
+
+// GetWorkflowOutputSchema builds a JSON Schema document for a workflow's draft output,
+// generated from OutputParams via the vo.Variable conversion, so callers (e.g. codegen or
+// downstream validation) can know the shape of a workflow's result without running it first.
+func (i *impl) GetWorkflowOutputSchema(ctx context.Context, id int64) (string, error) {
+	wf, err := i.Get(ctx, &vo.GetPolicy{ID: id, QType: workflowModel.FromDraft})
+	if err != nil {
+		return "", err
+	}
+
+	if len(wf.OutputParams) == 0 {
+		usesAnswerContent, err := canvasEndsWithAnswerContent(wf.CanvasInfo.Canvas)
+		if err != nil {
+			return "", err
+		}
+		if usesAnswerContent {
+			schemaStr, err := sonic.MarshalString(map[string]any{"type": "string"})
+			if err != nil {
+				return "", vo.WrapError(errno.ErrSerializationDeserializationFail, err)
+			}
+			return schemaStr, nil
+		}
+	}
+
+	properties := make(map[string]any, len(wf.OutputParams))
+	required := make([]string, 0, len(wf.OutputParams))
+	for _, param := range wf.OutputParams {
+		v, err := param.ToVariable()
+		if err != nil {
+			return "", vo.WrapError(errno.ErrSchemaConversionFail, err)
+		}
+		properties[v.Name] = v.ToJSONSchema()
+		if v.Required {
+			required = append(required, v.Name)
+		}
+	}
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	schemaStr, err := sonic.MarshalString(schema)
+	if err != nil {
+		return "", vo.WrapError(errno.ErrSerializationDeserializationFail, err)
+	}
+
+	return schemaStr, nil
+}
+
+// canvasEndsWithAnswerContent reports whether the workflow's Exit node is configured to
+// terminate by streaming its answer content (TerminatePlan == UseAnswerContent), in which
+// case the workflow's output is free-text rather than a set of structured OutputParams.
+func canvasEndsWithAnswerContent(canvasStr string) (bool, error) {
+	if canvasStr == "" {
+		return false, nil
+	}
+
+	var canvas vo.Canvas
+	if err := sonic.UnmarshalString(canvasStr, &canvas); err != nil {
+		return false, vo.WrapError(errno.ErrSchemaConversionFail, err)
+	}
+
+	for _, n := range canvas.Nodes {
+		if n.ID != entity.ExitNodeKey {
+			continue
+		}
+		return n.Data.Inputs.TerminatePlan != nil && *n.Data.Inputs.TerminatePlan == vo.UseAnswerContent, nil
+	}
+
+	return false, nil
+}
+
 func (i *impl) collectNodePropertyMap(ctx context.Context, canvas *vo.Canvas) (map[string]*vo.NodeProperty, error) {
 	nodePropertyMap := make(map[string]*vo.NodeProperty)
 
@@ -539,6 +971,173 @@ func isRefGlobalVariable(s *schema.NodeSchema) bool {
 	return false
 }
 
+// FindGlobalVariableUsages scans every draft workflow in the given space for nodes that
+// reference the named global variable, so callers can warn users before a rename or
+// deletion silently breaks those workflows.
+func (i *impl) FindGlobalVariableUsages(ctx context.Context, spaceID int64, varType vo.GlobalVarType, varName string) ([]*vo.GlobalVariableUsage, error) {
+	workflows, _, err := i.repo.MGetDrafts(ctx, &vo.MGetPolicy{MetaQuery: vo.MetaQuery{SpaceID: &spaceID}})
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]*vo.GlobalVariableUsage, 0)
+	for _, wf := range workflows {
+		if wf.CanvasInfo == nil || len(wf.CanvasInfo.Canvas) == 0 {
+			continue
+		}
+
+		c := &vo.Canvas{}
+		if err = sonic.UnmarshalString(wf.CanvasInfo.Canvas, c); err != nil {
+			return nil, vo.WrapError(errno.ErrSchemaConversionFail, err)
+		}
+
+		wfUsages, err := i.findGlobalVariableUsagesInCanvas(ctx, wf.ID, c, varType, varName)
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, wfUsages...)
+	}
+
+	return usages, nil
+}
+
+func (i *impl) findGlobalVariableUsagesInCanvas(ctx context.Context, wfID int64, c *vo.Canvas, varType vo.GlobalVarType, varName string) ([]*vo.GlobalVariableUsage, error) {
+	usages := make([]*vo.GlobalVariableUsage, 0)
+
+	for _, n := range c.Nodes {
+		var nodeSchemas []*schema.NodeSchema
+		if n.Type == entity.NodeTypeSubWorkflow.IDStr() {
+			ns := &schema.NodeSchema{Key: vo.NodeKey(n.ID), Type: entity.NodeTypeSubWorkflow, Name: n.Data.Meta.Title}
+			if err := convert.SetInputsForNodeSchema(n, ns); err != nil {
+				return nil, err
+			}
+			nodeSchemas = []*schema.NodeSchema{ns}
+		} else {
+			var err error
+			nodeSchemas, _, err = adaptor.NodeToNodeSchema(ctx, n, c)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, ns := range nodeSchemas {
+			if nodeSchemaRefsGlobalVariable(ns, varType, varName) {
+				usages = append(usages, &vo.GlobalVariableUsage{
+					WorkflowID: wfID,
+					NodeID:     n.ID,
+					NodeName:   n.Data.Meta.Title,
+				})
+				break
+			}
+		}
+	}
+
+	return usages, nil
+}
+
+func nodeSchemaRefsGlobalVariable(s *schema.NodeSchema, varType vo.GlobalVarType, varName string) bool {
+	refs := func(sources []*vo.FieldInfo) bool {
+		for _, source := range sources {
+			if !source.IsRefGlobalVariable() {
+				continue
+			}
+			ref := source.Source.Ref
+			if *ref.VariableType == varType && len(ref.FromPath) > 0 && ref.FromPath[0] == varName {
+				return true
+			}
+		}
+		return false
+	}
+	return refs(s.InputSources) || refs(s.OutputSources)
+}
+
+// RenameGlobalVariable rewrites every node reference to oldName as newName, across all draft
+// workflows in the space that reference it, and re-saves each affected draft. It stops and
+// returns an error on the first workflow it fails to save, leaving any already-saved workflows
+// renamed; callers that need an all-or-nothing guarantee should dry-run FindGlobalVariableUsages
+// first and surface the affected workflow list for confirmation.
+func (i *impl) RenameGlobalVariable(ctx context.Context, spaceID int64, varType vo.GlobalVarType, oldName, newName string) ([]*vo.GlobalVariableUsage, error) {
+	usages, err := i.FindGlobalVariableUsages(ctx, spaceID, varType, oldName)
+	if err != nil {
+		return nil, err
+	}
+
+	affected := make(map[int64]struct{}, len(usages))
+	for _, u := range usages {
+		affected[u.WorkflowID] = struct{}{}
+	}
+
+	for wfID := range affected {
+		draft, err := i.repo.DraftV2(ctx, wfID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		renamed, err := renameGlobalVariableRefsInCanvas(draft.Canvas, varType, oldName, newName)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = i.Save(ctx, wfID, renamed); err != nil {
+			return nil, err
+		}
+	}
+
+	return usages, nil
+}
+
+// globalRefSourceTypes maps each GlobalVarType to the RefSourceType string it's serialized as
+// on the canvas wire format, e.g. vo.BlockInputReference.Source.
+var globalRefSourceTypes = map[vo.GlobalVarType]string{
+	vo.GlobalUser:   string(vo.RefSourceTypeGlobalUser),
+	vo.GlobalSystem: string(vo.RefSourceTypeGlobalSystem),
+	vo.GlobalAPP:    string(vo.RefSourceTypeGlobalApp),
+}
+
+// renameGlobalVariableRefsInCanvas walks the raw canvas JSON and renames the first element of
+// every BlockInputReference.Path that matches (varType, oldName). It operates on the decoded
+// JSON tree rather than the typed Canvas struct because a global variable reference can appear
+// inside any of the node-specific, loosely-typed Inputs shapes (LLMParam, VariableTypeMap, etc.),
+// not just the common InputParameters field.
+func renameGlobalVariableRefsInCanvas(canvas string, varType vo.GlobalVarType, oldName, newName string) (string, error) {
+	sourceType, ok := globalRefSourceTypes[varType]
+	if !ok {
+		return "", fmt.Errorf("unsupported global variable type: %s", varType)
+	}
+
+	var tree any
+	if err := sonic.UnmarshalString(canvas, &tree); err != nil {
+		return "", vo.WrapError(errno.ErrSchemaConversionFail, err)
+	}
+
+	renameGlobalVariableRefsInJSON(tree, sourceType, oldName, newName)
+
+	out, err := sonic.MarshalString(tree)
+	if err != nil {
+		return "", vo.WrapError(errno.ErrSerializationDeserializationFail, err)
+	}
+
+	return out, nil
+}
+
+func renameGlobalVariableRefsInJSON(node any, sourceType, oldName, newName string) {
+	switch v := node.(type) {
+	case map[string]any:
+		if src, ok := v["source"].(string); ok && src == sourceType {
+			if path, ok := v["path"].([]any); ok && len(path) > 0 && path[0] == oldName {
+				path[0] = newName
+			}
+		}
+		for _, child := range v {
+			renameGlobalVariableRefsInJSON(child, sourceType, oldName, newName)
+		}
+	case []any:
+		for _, child := range v {
+			renameGlobalVariableRefsInJSON(child, sourceType, oldName, newName)
+		}
+	}
+}
+
 func (i *impl) CreateChatFlowRole(ctx context.Context, role *vo.ChatFlowRoleCreate) (int64, error) {
 	id, err := i.repo.CreateChatFlowRoleConfig(ctx, &entity.ChatFlowRole{
 		Name:                role.Name,
@@ -588,6 +1187,86 @@ func (i *impl) GetWorkflowVersionsByConnector(ctx context.Context, connectorID,
 	return i.repo.GetVersionListByConnectorAndWorkflowID(ctx, connectorID, workflowID, limit)
 }
 
+func (i *impl) DeprecateWorkflowVersion(ctx context.Context, id int64, version string, message string) error {
+	return i.repo.DeprecateVersion(ctx, id, version, message)
+}
+
+func (i *impl) ListWorkflowVersions(ctx context.Context, id int64) ([]*vo.VersionMeta, error) {
+	return i.repo.ListVersions(ctx, id)
+}
+
+func (i *impl) GetWorkflowChangelog(ctx context.Context, id int64) ([]*vo.ChangelogEntry, error) {
+	versions, err := i.repo.ListVersionsWithCanvas(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*vo.ChangelogEntry, 0, len(versions))
+	var prevNodes map[string]string
+	for _, v := range versions {
+		nodes, err := canvasNodeTitlesByID(v.Canvas)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := &vo.ChangelogEntry{
+			Version:            v.Version,
+			VersionDescription: v.VersionDescription,
+			VersionCreatedAt:   v.VersionCreatedAt,
+			VersionCreatorID:   v.VersionCreatorID,
+		}
+		if prevNodes != nil {
+			entry.NodesAdded, entry.NodesRemoved = diffCanvasNodes(prevNodes, nodes)
+		}
+		entries = append(entries, entry)
+
+		prevNodes = nodes
+	}
+
+	return entries, nil
+}
+
+// canvasNodeTitlesByID parses canvasStr and returns each node's display title (falling back to
+// its node ID when no title is set), keyed by node ID, for diffing between versions.
+func canvasNodeTitlesByID(canvasStr string) (map[string]string, error) {
+	if canvasStr == "" {
+		return map[string]string{}, nil
+	}
+
+	var canvas vo.Canvas
+	if err := sonic.UnmarshalString(canvasStr, &canvas); err != nil {
+		return nil, vo.WrapError(errno.ErrSchemaConversionFail, err)
+	}
+
+	titles := make(map[string]string, len(canvas.Nodes))
+	for _, n := range canvas.Nodes {
+		title := n.ID
+		if n.Data != nil && n.Data.Meta != nil && n.Data.Meta.Title != "" {
+			title = n.Data.Meta.Title
+		}
+		titles[n.ID] = title
+	}
+
+	return titles, nil
+}
+
+// diffCanvasNodes compares two versions' node title-by-ID maps and returns the titles of nodes
+// added and removed between them, for GetWorkflowChangelog's release-notes-style summary.
+func diffCanvasNodes(prev, curr map[string]string) (added, removed []string) {
+	for id, title := range curr {
+		if _, ok := prev[id]; !ok {
+			added = append(added, title)
+		}
+	}
+	for id, title := range prev {
+		if _, ok := curr[id]; !ok {
+			removed = append(removed, title)
+		}
+	}
+
+	return added, removed
+}
+
 func (i *impl) DeleteChatFlowRole(ctx context.Context, id int64, workflowID int64) error {
 	return i.repo.DeleteChatFlowRoleConfig(ctx, id, workflowID)
 }
@@ -770,7 +1449,7 @@ func (i *impl) CopyWorkflow(ctx context.Context, workflowID int64, policy vo.Cop
 		return nil, err
 	}
 	// chat flow should copy role config
-	if wf.Mode == cloudworkflow.WorkflowMode_ChatFlow {
+	if wf.IsChatFlow() {
 		role, err, isExist := i.repo.GetChatFlowRoleConfig(ctx, workflowID, "")
 		if !isExist {
 			logs.CtxErrorf(ctx, "get draft chat flow role nil, workflow id %v", workflowID)
@@ -862,7 +1541,7 @@ func (i *impl) ReleaseApplicationWorkflows(ctx context.Context, appID int64, con
 		}
 
 		if len(issues) > 0 {
-			vIssues = append(vIssues, toValidateIssue(wf.ID, wf.Name, issues))
+			vIssues = append(vIssues, toValidateIssue(ctx, wf.ID, wf.Name, issues))
 		}
 
 	}
@@ -1001,7 +1680,7 @@ func (i *impl) CopyWorkflowFromAppToLibrary(ctx context.Context, workflowID int6
 	}
 
 	if len(issues) > 0 {
-		vIssues = append(vIssues, toValidateIssue(workflowID, wid2Named[workflowID], issues))
+		vIssues = append(vIssues, toValidateIssue(ctx, workflowID, wid2Named[workflowID], issues))
 	}
 
 	var validateAndBuildWorkflowReference func(nodes []*vo.Node, wf *copiedWorkflow) error
@@ -1037,7 +1716,7 @@ func (i *impl) CopyWorkflowFromAppToLibrary(ctx context.Context, workflowID int6
 					}
 
 					if len(issues) > 0 {
-						vIssues = append(vIssues, toValidateIssue(wfID, wid2Named[wfID], issues))
+						vIssues = append(vIssues, toValidateIssue(ctx, wfID, wid2Named[wfID], issues))
 					}
 					hasVerifiedWorkflowIDMap[wfID] = true
 				}
@@ -1091,7 +1770,7 @@ func (i *impl) CopyWorkflowFromAppToLibrary(ctx context.Context, workflowID int6
 							}
 
 							if len(issues) > 0 {
-								vIssues = append(vIssues, toValidateIssue(wfID, wid2Named[wfID], issues))
+								vIssues = append(vIssues, toValidateIssue(ctx, wfID, wid2Named[wfID], issues))
 							}
 							hasVerifiedWorkflowIDMap[wfID] = true
 						}
@@ -27,6 +27,7 @@ import (
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/canvas/adaptor"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/internal/canvas/validate"
 	"github.com/coze-dev/coze-studio/backend/domain/workflow/variable"
+	"github.com/coze-dev/coze-studio/backend/pkg/lang/ptr"
 	"github.com/coze-dev/coze-studio/backend/pkg/sonic"
 	"github.com/coze-dev/coze-studio/backend/types/errno"
 )
@@ -45,6 +46,8 @@ func validateWorkflowTree(ctx context.Context, config vo.ValidateTreeConfig) ([]
 		AppID:               config.AppID,
 		AgentID:             config.AgentID,
 		VariablesMetaGetter: variable.GetVariablesMetaGetter(),
+		TargetAppID:         config.TargetAppID,
+		TargetSpaceID:       config.TargetSpaceID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to new canvas validate : %w", err)
@@ -67,6 +70,14 @@ func validateWorkflowTree(ctx context.Context, config vo.ValidateTreeConfig) ([]
 		return issues, nil
 	}
 
+	issues, err = validator.CheckEndNodeReachability(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check end node reachability: %w", err)
+	}
+	if len(issues) > 0 {
+		return issues, nil
+	}
+
 	issues, err = validator.ValidateNestedFlows(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check nested batch or recurse: %w", err)
@@ -83,6 +94,14 @@ func validateWorkflowTree(ctx context.Context, config vo.ValidateTreeConfig) ([]
 		return issues, nil
 	}
 
+	issues, err = validator.CheckInputReferenceTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check input reference types: %w", err)
+	}
+	if len(issues) > 0 {
+		return issues, nil
+	}
+
 	issues, err = validator.CheckGlobalVariables(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check global variables: %w", err)
@@ -99,12 +118,34 @@ func validateWorkflowTree(ctx context.Context, config vo.ValidateTreeConfig) ([]
 		return issues, nil
 	}
 
+	// Only runs when TargetAppID/TargetSpaceID is set, i.e. when validating ahead of a copy or
+	// move, so it's a no-op for ordinary in-place validation.
+	issues, err = validator.CheckExternalResourceReferences(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check external resource references: %w", err)
+	}
+	if len(issues) > 0 {
+		return issues, nil
+	}
+
+	// Deprecated-node usage is a warning, not an error, so it's appended rather than
+	// short-circuiting the checks above.
+	warnings, err := validator.CheckDeprecatedNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check deprecated nodes: %w", err)
+	}
+	issues = append(issues, warnings...)
+
 	return issues, nil
 }
 
-func convertToValidationError(issue *validate.Issue) *workflow.ValidateErrorData {
+func convertToValidationError(ctx context.Context, issue *validate.Issue) *workflow.ValidateErrorData {
 	e := &workflow.ValidateErrorData{}
-	e.Message = issue.Message
+	e.Message = issue.LocalizedMessage(ctx)
+	if issue.Code != "" {
+		e.Code = ptr.Of(string(issue.Code))
+	}
+	e.IsWarning = issue.IsWarning
 	if issue.NodeErr != nil {
 		e.Type = workflow.ValidateErrorType_BotValidateNodeErr
 		e.NodeError = &workflow.NodeError{
@@ -121,21 +162,40 @@ func convertToValidationError(issue *validate.Issue) *workflow.ValidateErrorData
 	return e
 }
 
-func toValidateErrorData(issues []*validate.Issue) []*workflow.ValidateErrorData {
+func toValidateErrorData(ctx context.Context, issues []*validate.Issue) []*workflow.ValidateErrorData {
 	validateErrors := make([]*workflow.ValidateErrorData, 0, len(issues))
 	for _, issue := range issues {
-		validateErrors = append(validateErrors, convertToValidationError(issue))
+		validateErrors = append(validateErrors, convertToValidationError(ctx, issue))
 	}
 	return validateErrors
 }
 
-func toValidateIssue(id int64, name string, issues []*validate.Issue) *vo.ValidateIssue {
+// groupValidateErrorsByElement groups errData by the node ID or edge it was raised against, for
+// callers that want to overlay issues directly onto canvas elements (see ValidateTreeRequest's
+// annotate_by_element). Issues with neither a NodeError nor a PathError (there are none today, but
+// Issue doesn't guarantee one is always set) end up in neither map.
+func groupValidateErrorsByElement(errData []*workflow.ValidateErrorData) (byNode, byEdge map[string][]*workflow.ValidateErrorData) {
+	byNode = make(map[string][]*workflow.ValidateErrorData)
+	byEdge = make(map[string][]*workflow.ValidateErrorData)
+	for _, e := range errData {
+		switch {
+		case e.NodeError != nil:
+			byNode[e.NodeError.NodeID] = append(byNode[e.NodeError.NodeID], e)
+		case e.PathError != nil:
+			edgeID := e.PathError.Start + "->" + e.PathError.End
+			byEdge[edgeID] = append(byEdge[edgeID], e)
+		}
+	}
+	return byNode, byEdge
+}
+
+func toValidateIssue(ctx context.Context, id int64, name string, issues []*validate.Issue) *vo.ValidateIssue {
 	vIssue := &vo.ValidateIssue{
 		WorkflowID:   id,
 		WorkflowName: name,
 	}
 	for _, issue := range issues {
-		vIssue.IssueMessages = append(vIssue.IssueMessages, issue.Message)
+		vIssue.IssueMessages = append(vIssue.IssueMessages, issue.LocalizedMessage(ctx))
 	}
 	return vIssue
 }
@@ -37,6 +37,9 @@ type Cmdable interface {
 
 type StringCmdable interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) StatusCmd
+	// SetNX sets key to value with the given expiration only if key does not already exist,
+	// returning whether the key was set. Used for lock-style acquisition.
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) BoolCmd
 	Get(ctx context.Context, key string) StringCmd
 	IncrBy(ctx context.Context, key string, value int64) IntCmd
 	Incr(ctx context.Context, key string) IntCmd
@@ -45,6 +48,7 @@ type StringCmdable interface {
 type HashCmdable interface {
 	HSet(ctx context.Context, key string, values ...interface{}) IntCmd
 	HGetAll(ctx context.Context, key string) MapStringStringCmd
+	HDel(ctx context.Context, key string, fields ...string) IntCmd
 }
 
 type GenericCmdable interface {
@@ -81,6 +81,11 @@ func (r *redisImpl) Get(ctx context.Context, key string) cache.StringCmd {
 	return r.client.Get(ctx, key)
 }
 
+// HDel implements cache.Cmdable.
+func (r *redisImpl) HDel(ctx context.Context, key string, fields ...string) cache.IntCmd {
+	return r.client.HDel(ctx, key, fields...)
+}
+
 // HGetAll implements cache.Cmdable.
 func (r *redisImpl) HGetAll(ctx context.Context, key string) cache.MapStringStringCmd {
 	return r.client.HGetAll(ctx, key)
@@ -142,6 +147,11 @@ func (r *redisImpl) Set(ctx context.Context, key string, value interface{}, expi
 	return r.client.Set(ctx, key, value, expiration)
 }
 
+// SetNX implements cache.Cmdable.
+func (r *redisImpl) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) cache.BoolCmd {
+	return r.client.SetNX(ctx, key, value, expiration)
+}
+
 type pipelineImpl struct {
 	p redis.Pipeliner
 }
@@ -191,6 +201,11 @@ func (p *pipelineImpl) Get(ctx context.Context, key string) cache.StringCmd {
 	return p.p.Get(ctx, key)
 }
 
+// HDel implements cache.Pipeliner.
+func (p *pipelineImpl) HDel(ctx context.Context, key string, fields ...string) cache.IntCmd {
+	return p.p.HDel(ctx, key, fields...)
+}
+
 // HGetAll implements cache.Pipeliner.
 func (p *pipelineImpl) HGetAll(ctx context.Context, key string) cache.MapStringStringCmd {
 	return p.p.HGetAll(ctx, key)
@@ -250,3 +265,8 @@ func (p *pipelineImpl) RPush(ctx context.Context, key string, values ...interfac
 func (p *pipelineImpl) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) cache.StatusCmd {
 	return p.p.Set(ctx, key, value, expiration)
 }
+
+// SetNX implements cache.Pipeliner.
+func (p *pipelineImpl) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) cache.BoolCmd {
+	return p.p.SetNX(ctx, key, value, expiration)
+}
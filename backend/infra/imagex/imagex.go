@@ -27,6 +27,8 @@ type ImageX interface {
 	GetUploadAuthWithExpire(ctx context.Context, expire time.Duration, opt ...UploadAuthOpt) (*SecurityToken, error)
 	GetResourceURL(ctx context.Context, uri string, opts ...GetResourceOpt) (*ResourceURL, error)
 	Upload(ctx context.Context, data []byte, opts ...UploadAuthOpt) (*UploadResult, error)
+	// DeleteObject removes the object with the specified uri.
+	DeleteObject(ctx context.Context, uri string) error
 	GetServerID() string
 	GetUploadHost(ctx context.Context) string
 }
@@ -246,6 +246,23 @@ func (v *veImageX) Upload(ctx context.Context, data []byte, opts ...imagex.Uploa
 	return r, nil
 }
 
+func (v *veImageX) DeleteObject(ctx context.Context, uri string) error {
+	if len(v.serverIDs) == 0 {
+		return errors.New("serverIDs is empty")
+	}
+
+	instance := veimagex.DefaultInstance
+	_, err := instance.DeleteImageUploadFiles(ctx, &veimagex.DeleteImageUploadFilesReq{
+		DeleteImageUploadFilesQuery: &veimagex.DeleteImageUploadFilesQuery{
+			ServiceID: v.serverIDs[0],
+		},
+		DeleteImageUploadFilesBody: &veimagex.DeleteImageUploadFilesBody{
+			StoreUris: []string{uri},
+		},
+	})
+	return err
+}
+
 func (v *veImageX) GetServerID() string {
 	return v.serverIDs[0]
 }
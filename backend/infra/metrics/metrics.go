@@ -0,0 +1,69 @@
+/*
+ * Copyright 2025 coze-dev Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metrics exposes the process-wide Prometheus registry that domain
+// code instruments against. It wraps prometheus.DefaultRegisterer so the
+// counters/histograms registered here show up next to any other metrics an
+// operator has already wired into the default registry, without requiring
+// every caller to plumb a registry handle through constructors.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "coze_studio"
+
+var (
+	WorkflowExecutionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "workflow",
+		Name:      "executions_total",
+		Help:      "Total number of workflow executions, by execution mode and final status.",
+	}, []string{"mode", "status"})
+
+	NodeExecutionDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "workflow",
+		Name:      "node_execution_duration_seconds",
+		Help:      "Duration of individual node executions, by node type and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"node_type", "status"})
+
+	TokenUsageTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "workflow",
+		Name:      "token_usage_total",
+		Help:      "Total number of tokens consumed by workflow executions, by direction (input/output).",
+	}, []string{"direction"})
+
+	InterruptsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "workflow",
+		Name:      "interrupts_total",
+		Help:      "Total number of workflow execution interrupts.",
+	}, []string{"mode"})
+)
+
+// Handler returns the HTTP handler that serves the default Prometheus
+// registry, for mounting at a /metrics route.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
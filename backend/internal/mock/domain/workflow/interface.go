@@ -28,12 +28,13 @@ package mockWorkflow
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	compose "github.com/cloudwego/eino/compose"
 	schema "github.com/cloudwego/eino/schema"
-	workflow "github.com/coze-dev/coze-studio/backend/crossdomain/workflow/model"
 	workflow0 "github.com/coze-dev/coze-studio/backend/api/model/workflow"
 	"github.com/coze-dev/coze-studio/backend/bizpkg/llm/modelbuilder"
+	workflow "github.com/coze-dev/coze-studio/backend/crossdomain/workflow/model"
 	workflow1 "github.com/coze-dev/coze-studio/backend/domain/workflow"
 	config "github.com/coze-dev/coze-studio/backend/domain/workflow/config"
 	entity "github.com/coze-dev/coze-studio/backend/domain/workflow/entity"
@@ -66,6 +67,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AcquireEditLock mocks base method.
+func (m *MockService) AcquireEditLock(ctx context.Context, workflowID, holderID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireEditLock", ctx, workflowID, holderID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireEditLock indicates an expected call of AcquireEditLock.
+func (mr *MockServiceMockRecorder) AcquireEditLock(ctx, workflowID, holderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireEditLock", reflect.TypeOf((*MockService)(nil).AcquireEditLock), ctx, workflowID, holderID)
+}
+
 // AsyncExecute mocks base method.
 func (m *MockService) AsyncExecute(ctx context.Context, arg1 workflow.ExecuteConfig, input map[string]any) (int64, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +154,21 @@ func (mr *MockServiceMockRecorder) Cancel(ctx, wfExeID, wfID, spaceID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockService)(nil).Cancel), ctx, wfExeID, wfID, spaceID)
 }
 
+// GetVariantStats mocks base method.
+func (m *MockService) GetVariantStats(ctx context.Context, wfID int64, from, to time.Time) ([]*entity.VariantStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantStats", ctx, wfID, from, to)
+	ret0, _ := ret[0].([]*entity.VariantStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantStats indicates an expected call of GetVariantStats.
+func (mr *MockServiceMockRecorder) GetVariantStats(ctx, wfID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantStats", reflect.TypeOf((*MockService)(nil).GetVariantStats), ctx, wfID, from, to)
+}
+
 // CheckWorkflowsToReplace mocks base method.
 func (m *MockService) CheckWorkflowsToReplace(ctx context.Context, appID, templateID int64) ([]*entity.Workflow, error) {
 	m.ctrl.T.Helper()
@@ -302,6 +333,80 @@ func (mr *MockServiceMockRecorder) DuplicateWorkflowsByAppID(ctx, sourceAPPID, t
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateWorkflowsByAppID", reflect.TypeOf((*MockService)(nil).DuplicateWorkflowsByAppID), ctx, sourceAPPID, targetAppID, related)
 }
 
+// GenerateInputExample mocks base method.
+func (m *MockService) GenerateInputExample(ctx context.Context, id int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateInputExample", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateInputExample indicates an expected call of GenerateInputExample.
+func (mr *MockServiceMockRecorder) GenerateInputExample(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateInputExample", reflect.TypeOf((*MockService)(nil).GenerateInputExample), ctx, id)
+}
+
+// GetWorkflowOutputSchema mocks base method.
+func (m *MockService) GetWorkflowOutputSchema(ctx context.Context, id int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowOutputSchema", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowOutputSchema indicates an expected call of GetWorkflowOutputSchema.
+func (mr *MockServiceMockRecorder) GetWorkflowOutputSchema(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowOutputSchema", reflect.TypeOf((*MockService)(nil).GetWorkflowOutputSchema), ctx, id)
+}
+
+// RestoreDraftSnapshot mocks base method.
+func (m *MockService) RestoreDraftSnapshot(ctx context.Context, id int64, commitID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDraftSnapshot", ctx, id, commitID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDraftSnapshot indicates an expected call of RestoreDraftSnapshot.
+func (mr *MockServiceMockRecorder) RestoreDraftSnapshot(ctx, id, commitID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDraftSnapshot", reflect.TypeOf((*MockService)(nil).RestoreDraftSnapshot), ctx, id, commitID)
+}
+
+// SaveDraftSnapshot mocks base method.
+func (m *MockService) SaveDraftSnapshot(ctx context.Context, id int64) (*vo.DraftSnapshotMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDraftSnapshot", ctx, id)
+	ret0, _ := ret[0].(*vo.DraftSnapshotMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDraftSnapshot indicates an expected call of SaveDraftSnapshot.
+func (mr *MockServiceMockRecorder) SaveDraftSnapshot(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDraftSnapshot", reflect.TypeOf((*MockService)(nil).SaveDraftSnapshot), ctx, id)
+}
+
+// ListDraftSnapshots mocks base method.
+func (m *MockService) ListDraftSnapshots(ctx context.Context, id int64) ([]*vo.DraftSnapshotMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDraftSnapshots", ctx, id)
+	ret0, _ := ret[0].([]*vo.DraftSnapshotMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDraftSnapshots indicates an expected call of ListDraftSnapshots.
+func (mr *MockServiceMockRecorder) ListDraftSnapshots(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDraftSnapshots", reflect.TypeOf((*MockService)(nil).ListDraftSnapshots), ctx, id)
+}
+
 // Get mocks base method.
 func (m *MockService) Get(ctx context.Context, policy *vo.GetPolicy) (*entity.Workflow, error) {
 	m.ctrl.T.Helper()
@@ -381,6 +486,125 @@ func (mr *MockServiceMockRecorder) GetDynamicConversationByName(ctx, env, appID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConversationByName", reflect.TypeOf((*MockService)(nil).GetDynamicConversationByName), ctx, env, appID, connectorID, userID, name)
 }
 
+// GetEditLock mocks base method.
+func (m *MockService) GetEditLock(ctx context.Context, workflowID int64) (*entity.EditLock, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditLock", ctx, workflowID)
+	ret0, _ := ret[0].(*entity.EditLock)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEditLock indicates an expected call of GetEditLock.
+func (mr *MockServiceMockRecorder) GetEditLock(ctx, workflowID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditLock", reflect.TypeOf((*MockService)(nil).GetEditLock), ctx, workflowID)
+}
+
+// GetCachedOpenAPIResult mocks base method.
+func (m *MockService) GetCachedOpenAPIResult(ctx context.Context, key string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCachedOpenAPIResult", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCachedOpenAPIResult indicates an expected call of GetCachedOpenAPIResult.
+func (mr *MockServiceMockRecorder) GetCachedOpenAPIResult(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCachedOpenAPIResult", reflect.TypeOf((*MockService)(nil).GetCachedOpenAPIResult), ctx, key)
+}
+
+// CacheOpenAPIResult mocks base method.
+func (m *MockService) CacheOpenAPIResult(ctx context.Context, key, result string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheOpenAPIResult", ctx, key, result, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CacheOpenAPIResult indicates an expected call of CacheOpenAPIResult.
+func (mr *MockServiceMockRecorder) CacheOpenAPIResult(ctx, key, result, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheOpenAPIResult", reflect.TypeOf((*MockService)(nil).CacheOpenAPIResult), ctx, key, result, ttl)
+}
+
+// CreateRunShareToken mocks base method.
+func (m *MockService) CreateRunShareToken(ctx context.Context, workflowID, executeID int64, ttl time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRunShareToken", ctx, workflowID, executeID, ttl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRunShareToken indicates an expected call of CreateRunShareToken.
+func (mr *MockServiceMockRecorder) CreateRunShareToken(ctx, workflowID, executeID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRunShareToken", reflect.TypeOf((*MockService)(nil).CreateRunShareToken), ctx, workflowID, executeID, ttl)
+}
+
+// ParseRunShareToken mocks base method.
+func (m *MockService) ParseRunShareToken(ctx context.Context, token string) (*vo.RunShareClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseRunShareToken", ctx, token)
+	ret0, _ := ret[0].(*vo.RunShareClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseRunShareToken indicates an expected call of ParseRunShareToken.
+func (mr *MockServiceMockRecorder) ParseRunShareToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseRunShareToken", reflect.TypeOf((*MockService)(nil).ParseRunShareToken), ctx, token)
+}
+
+// SaveTestRunPreset mocks base method.
+func (m *MockService) SaveTestRunPreset(ctx context.Context, id, userID int64, name string, input map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTestRunPreset", ctx, id, userID, name, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTestRunPreset indicates an expected call of SaveTestRunPreset.
+func (mr *MockServiceMockRecorder) SaveTestRunPreset(ctx, id, userID, name, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTestRunPreset", reflect.TypeOf((*MockService)(nil).SaveTestRunPreset), ctx, id, userID, name, input)
+}
+
+// ListTestRunPresets mocks base method.
+func (m *MockService) ListTestRunPresets(ctx context.Context, id, userID int64) ([]*entity.TestRunPreset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTestRunPresets", ctx, id, userID)
+	ret0, _ := ret[0].([]*entity.TestRunPreset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTestRunPresets indicates an expected call of ListTestRunPresets.
+func (mr *MockServiceMockRecorder) ListTestRunPresets(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTestRunPresets", reflect.TypeOf((*MockService)(nil).ListTestRunPresets), ctx, id, userID)
+}
+
+// DeleteTestRunPreset mocks base method.
+func (m *MockService) DeleteTestRunPreset(ctx context.Context, id, userID int64, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTestRunPreset", ctx, id, userID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTestRunPreset indicates an expected call of DeleteTestRunPreset.
+func (mr *MockServiceMockRecorder) DeleteTestRunPreset(ctx, id, userID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTestRunPreset", reflect.TypeOf((*MockService)(nil).DeleteTestRunPreset), ctx, id, userID, name)
+}
+
 // GetExecution mocks base method.
 func (m *MockService) GetExecution(ctx context.Context, wfExe *entity.WorkflowExecution, includeNodes bool) (*entity.WorkflowExecution, error) {
 	m.ctrl.T.Helper()
@@ -429,6 +653,38 @@ func (mr *MockServiceMockRecorder) GetLatestTestRunInput(ctx, wfID, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestTestRunInput", reflect.TypeOf((*MockService)(nil).GetLatestTestRunInput), ctx, wfID, userID)
 }
 
+// GetLatestSuccessfulExecution mocks base method.
+func (m *MockService) GetLatestSuccessfulExecution(ctx context.Context, wfID, userID int64) (*entity.WorkflowExecution, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestSuccessfulExecution", ctx, wfID, userID)
+	ret0, _ := ret[0].(*entity.WorkflowExecution)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLatestSuccessfulExecution indicates an expected call of GetLatestSuccessfulExecution.
+func (mr *MockServiceMockRecorder) GetLatestSuccessfulExecution(ctx, wfID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestSuccessfulExecution", reflect.TypeOf((*MockService)(nil).GetLatestSuccessfulExecution), ctx, wfID, userID)
+}
+
+// GetLatestFailedExecution mocks base method.
+func (m *MockService) GetLatestFailedExecution(ctx context.Context, wfID, userID int64) (*entity.WorkflowExecution, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestFailedExecution", ctx, wfID, userID)
+	ret0, _ := ret[0].(*entity.WorkflowExecution)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLatestFailedExecution indicates an expected call of GetLatestFailedExecution.
+func (mr *MockServiceMockRecorder) GetLatestFailedExecution(ctx, wfID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestFailedExecution", reflect.TypeOf((*MockService)(nil).GetLatestFailedExecution), ctx, wfID, userID)
+}
+
 // GetNodeExecution mocks base method.
 func (m *MockService) GetNodeExecution(ctx context.Context, exeID int64, nodeID string) (*entity.NodeExecution, *entity.NodeExecution, error) {
 	m.ctrl.T.Helper()
@@ -477,6 +733,21 @@ func (mr *MockServiceMockRecorder) GetTemplateByName(ctx, env, appID, templateNa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByName", reflect.TypeOf((*MockService)(nil).GetTemplateByName), ctx, env, appID, templateName)
 }
 
+// GetWorkflowComplexity mocks base method.
+func (m *MockService) GetWorkflowComplexity(ctx context.Context, workflowID int64) (*vo.WorkflowComplexity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowComplexity", ctx, workflowID)
+	ret0, _ := ret[0].(*vo.WorkflowComplexity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowComplexity indicates an expected call of GetWorkflowComplexity.
+func (mr *MockServiceMockRecorder) GetWorkflowComplexity(ctx, workflowID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowComplexity", reflect.TypeOf((*MockService)(nil).GetWorkflowComplexity), ctx, workflowID)
+}
+
 // GetWorkflowDependenceResource mocks base method.
 func (m *MockService) GetWorkflowDependenceResource(ctx context.Context, workflowID int64) (*vo.DependenceResource, error) {
 	m.ctrl.T.Helper()
@@ -507,6 +778,82 @@ func (mr *MockServiceMockRecorder) GetWorkflowReference(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowReference", reflect.TypeOf((*MockService)(nil).GetWorkflowReference), ctx, id)
 }
 
+// FindWorkflowsUsingPlugin mocks base method.
+func (m *MockService) FindWorkflowsUsingPlugin(ctx context.Context, spaceID, pluginID int64) ([]*vo.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWorkflowsUsingPlugin", ctx, spaceID, pluginID)
+	ret0, _ := ret[0].([]*vo.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWorkflowsUsingPlugin indicates an expected call of FindWorkflowsUsingPlugin.
+func (mr *MockServiceMockRecorder) FindWorkflowsUsingPlugin(ctx, spaceID, pluginID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWorkflowsUsingPlugin", reflect.TypeOf((*MockService)(nil).FindWorkflowsUsingPlugin), ctx, spaceID, pluginID)
+}
+
+// FindWorkflowsUsingKnowledge mocks base method.
+func (m *MockService) FindWorkflowsUsingKnowledge(ctx context.Context, spaceID, knowledgeID int64, page *vo.Page) ([]*vo.Meta, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWorkflowsUsingKnowledge", ctx, spaceID, knowledgeID, page)
+	ret0, _ := ret[0].([]*vo.Meta)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindWorkflowsUsingKnowledge indicates an expected call of FindWorkflowsUsingKnowledge.
+func (mr *MockServiceMockRecorder) FindWorkflowsUsingKnowledge(ctx, spaceID, knowledgeID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWorkflowsUsingKnowledge", reflect.TypeOf((*MockService)(nil).FindWorkflowsUsingKnowledge), ctx, spaceID, knowledgeID, page)
+}
+
+// FindWorkflowsUsingDatabase mocks base method.
+func (m *MockService) FindWorkflowsUsingDatabase(ctx context.Context, spaceID, databaseID int64) ([]*vo.DatabaseUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWorkflowsUsingDatabase", ctx, spaceID, databaseID)
+	ret0, _ := ret[0].([]*vo.DatabaseUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWorkflowsUsingDatabase indicates an expected call of FindWorkflowsUsingDatabase.
+func (mr *MockServiceMockRecorder) FindWorkflowsUsingDatabase(ctx, spaceID, databaseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWorkflowsUsingDatabase", reflect.TypeOf((*MockService)(nil).FindWorkflowsUsingDatabase), ctx, spaceID, databaseID)
+}
+
+// FindGlobalVariableUsages mocks base method.
+func (m *MockService) FindGlobalVariableUsages(ctx context.Context, spaceID int64, varType vo.GlobalVarType, varName string) ([]*vo.GlobalVariableUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGlobalVariableUsages", ctx, spaceID, varType, varName)
+	ret0, _ := ret[0].([]*vo.GlobalVariableUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGlobalVariableUsages indicates an expected call of FindGlobalVariableUsages.
+func (mr *MockServiceMockRecorder) FindGlobalVariableUsages(ctx, spaceID, varType, varName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGlobalVariableUsages", reflect.TypeOf((*MockService)(nil).FindGlobalVariableUsages), ctx, spaceID, varType, varName)
+}
+
+// RenameGlobalVariable mocks base method.
+func (m *MockService) RenameGlobalVariable(ctx context.Context, spaceID int64, varType vo.GlobalVarType, oldName, newName string) ([]*vo.GlobalVariableUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameGlobalVariable", ctx, spaceID, varType, oldName, newName)
+	ret0, _ := ret[0].([]*vo.GlobalVariableUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameGlobalVariable indicates an expected call of RenameGlobalVariable.
+func (mr *MockServiceMockRecorder) RenameGlobalVariable(ctx, spaceID, varType, oldName, newName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameGlobalVariable", reflect.TypeOf((*MockService)(nil).RenameGlobalVariable), ctx, spaceID, varType, oldName, newName)
+}
+
 // GetWorkflowVersionsByConnector mocks base method.
 func (m *MockService) GetWorkflowVersionsByConnector(ctx context.Context, connectorID, workflowID int64, limit int) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -522,6 +869,65 @@ func (mr *MockServiceMockRecorder) GetWorkflowVersionsByConnector(ctx, connector
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowVersionsByConnector", reflect.TypeOf((*MockService)(nil).GetWorkflowVersionsByConnector), ctx, connectorID, workflowID, limit)
 }
 
+// DeprecateWorkflowVersion mocks base method.
+func (m *MockService) DeprecateWorkflowVersion(ctx context.Context, id int64, version, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeprecateWorkflowVersion", ctx, id, version, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeprecateWorkflowVersion indicates an expected call of DeprecateWorkflowVersion.
+func (mr *MockServiceMockRecorder) DeprecateWorkflowVersion(ctx, id, version, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprecateWorkflowVersion", reflect.TypeOf((*MockService)(nil).DeprecateWorkflowVersion), ctx, id, version, message)
+}
+
+// ListWorkflowVersions mocks base method.
+func (m *MockService) ListWorkflowVersions(ctx context.Context, id int64) ([]*vo.VersionMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowVersions", ctx, id)
+	ret0, _ := ret[0].([]*vo.VersionMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowVersions indicates an expected call of ListWorkflowVersions.
+func (mr *MockServiceMockRecorder) ListWorkflowVersions(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowVersions", reflect.TypeOf((*MockService)(nil).ListWorkflowVersions), ctx, id)
+}
+
+// GetWorkflowChangelog mocks base method.
+func (m *MockService) GetWorkflowChangelog(ctx context.Context, id int64) ([]*vo.ChangelogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowChangelog", ctx, id)
+	ret0, _ := ret[0].([]*vo.ChangelogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowChangelog indicates an expected call of GetWorkflowChangelog.
+func (mr *MockServiceMockRecorder) GetWorkflowChangelog(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowChangelog", reflect.TypeOf((*MockService)(nil).GetWorkflowChangelog), ctx, id)
+}
+
+// InferLatestTestRunOutputSchema mocks base method.
+func (m *MockService) InferLatestTestRunOutputSchema(ctx context.Context, wfID, userID int64) ([]*vo.Variable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InferLatestTestRunOutputSchema", ctx, wfID, userID)
+	ret0, _ := ret[0].([]*vo.Variable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InferLatestTestRunOutputSchema indicates an expected call of InferLatestTestRunOutputSchema.
+func (mr *MockServiceMockRecorder) InferLatestTestRunOutputSchema(ctx, wfID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InferLatestTestRunOutputSchema", reflect.TypeOf((*MockService)(nil).InferLatestTestRunOutputSchema), ctx, wfID, userID)
+}
+
 // InitApplicationDefaultConversationTemplate mocks base method.
 func (m *MockService) InitApplicationDefaultConversationTemplate(ctx context.Context, spaceID, appID, userID int64) error {
 	m.ctrl.T.Helper()
@@ -685,6 +1091,20 @@ func (mr *MockServiceMockRecorder) ReleaseConversationTemplate(ctx, appID, versi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseConversationTemplate", reflect.TypeOf((*MockService)(nil).ReleaseConversationTemplate), ctx, appID, version)
 }
 
+// ReleaseEditLock mocks base method.
+func (m *MockService) ReleaseEditLock(ctx context.Context, workflowID, holderID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseEditLock", ctx, workflowID, holderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseEditLock indicates an expected call of ReleaseEditLock.
+func (mr *MockServiceMockRecorder) ReleaseEditLock(ctx, workflowID, holderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseEditLock", reflect.TypeOf((*MockService)(nil).ReleaseEditLock), ctx, workflowID, holderID)
+}
+
 // Save mocks base method.
 func (m *MockService) Save(ctx context.Context, id int64, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -846,6 +1266,37 @@ func (mr *MockServiceMockRecorder) ValidateTree(ctx, id, validateConfig any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateTree", reflect.TypeOf((*MockService)(nil).ValidateTree), ctx, id, validateConfig)
 }
 
+// ValidateNode mocks base method.
+func (m *MockService) ValidateNode(ctx context.Context, nodeType entity.NodeType, nodeConfig string) ([]*workflow0.ValidateErrorData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateNode", ctx, nodeType, nodeConfig)
+	ret0, _ := ret[0].([]*workflow0.ValidateErrorData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateNode indicates an expected call of ValidateNode.
+func (mr *MockServiceMockRecorder) ValidateNode(ctx, nodeType, nodeConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateNode", reflect.TypeOf((*MockService)(nil).ValidateNode), ctx, nodeType, nodeConfig)
+}
+
+// MigrateCanvasSchema mocks base method.
+func (m *MockService) MigrateCanvasSchema(ctx context.Context, canvasSchema string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateCanvasSchema", ctx, canvasSchema)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MigrateCanvasSchema indicates an expected call of MigrateCanvasSchema.
+func (mr *MockServiceMockRecorder) MigrateCanvasSchema(ctx, canvasSchema any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateCanvasSchema", reflect.TypeOf((*MockService)(nil).MigrateCanvasSchema), ctx, canvasSchema)
+}
+
 // WithExecuteConfig mocks base method.
 func (m *MockService) WithExecuteConfig(cfg workflow.ExecuteConfig) compose.Option {
 	m.ctrl.T.Helper()
@@ -944,6 +1395,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AcquireEditLock mocks base method.
+func (m *MockRepository) AcquireEditLock(ctx context.Context, workflowID, holderID int64, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireEditLock", ctx, workflowID, holderID, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireEditLock indicates an expected call of AcquireEditLock.
+func (mr *MockRepositoryMockRecorder) AcquireEditLock(ctx, workflowID, holderID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireEditLock", reflect.TypeOf((*MockRepository)(nil).AcquireEditLock), ctx, workflowID, holderID, ttl)
+}
+
 // BatchCreateConnectorWorkflowVersion mocks base method.
 func (m *MockRepository) BatchCreateConnectorWorkflowVersion(ctx context.Context, appID, connectorID int64, workflowIDs []int64, version string) error {
 	m.ctrl.T.Helper()
@@ -1116,6 +1582,36 @@ func (mr *MockRepositoryMockRecorder) CreateSnapshotIfNeeded(ctx, id, commitID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotIfNeeded", reflect.TypeOf((*MockRepository)(nil).CreateSnapshotIfNeeded), ctx, id, commitID)
 }
 
+// SaveDraftSnapshot mocks base method.
+func (m *MockRepository) SaveDraftSnapshot(ctx context.Context, id int64) (*vo.DraftSnapshotMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDraftSnapshot", ctx, id)
+	ret0, _ := ret[0].(*vo.DraftSnapshotMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDraftSnapshot indicates an expected call of SaveDraftSnapshot.
+func (mr *MockRepositoryMockRecorder) SaveDraftSnapshot(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDraftSnapshot", reflect.TypeOf((*MockRepository)(nil).SaveDraftSnapshot), ctx, id)
+}
+
+// ListDraftSnapshots mocks base method.
+func (m *MockRepository) ListDraftSnapshots(ctx context.Context, id int64) ([]*vo.DraftSnapshotMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDraftSnapshots", ctx, id)
+	ret0, _ := ret[0].([]*vo.DraftSnapshotMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDraftSnapshots indicates an expected call of ListDraftSnapshots.
+func (mr *MockRepositoryMockRecorder) ListDraftSnapshots(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDraftSnapshots", reflect.TypeOf((*MockRepository)(nil).ListDraftSnapshots), ctx, id)
+}
+
 // CreateVersion mocks base method.
 func (m *MockRepository) CreateVersion(ctx context.Context, id int64, info *vo.VersionInfo, newRefs map[entity.WorkflowReferenceKey]struct{}) error {
 	m.ctrl.T.Helper()
@@ -1360,6 +1856,95 @@ func (mr *MockRepositoryMockRecorder) GetDynamicConversationByName(ctx, env, app
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConversationByName", reflect.TypeOf((*MockRepository)(nil).GetDynamicConversationByName), ctx, env, appID, connectorID, userID, name)
 }
 
+// GetEditLock mocks base method.
+func (m *MockRepository) GetEditLock(ctx context.Context, workflowID int64) (*entity.EditLock, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditLock", ctx, workflowID)
+	ret0, _ := ret[0].(*entity.EditLock)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEditLock indicates an expected call of GetEditLock.
+func (mr *MockRepositoryMockRecorder) GetEditLock(ctx, workflowID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditLock", reflect.TypeOf((*MockRepository)(nil).GetEditLock), ctx, workflowID)
+}
+
+// GetCachedResult mocks base method.
+func (m *MockRepository) GetCachedResult(ctx context.Context, key string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCachedResult", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCachedResult indicates an expected call of GetCachedResult.
+func (mr *MockRepositoryMockRecorder) GetCachedResult(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCachedResult", reflect.TypeOf((*MockRepository)(nil).GetCachedResult), ctx, key)
+}
+
+// SetCachedResult mocks base method.
+func (m *MockRepository) SetCachedResult(ctx context.Context, key, result string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCachedResult", ctx, key, result, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCachedResult indicates an expected call of SetCachedResult.
+func (mr *MockRepositoryMockRecorder) SetCachedResult(ctx, key, result, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCachedResult", reflect.TypeOf((*MockRepository)(nil).SetCachedResult), ctx, key, result, ttl)
+}
+
+// SaveTestRunPreset mocks base method.
+func (m *MockRepository) SaveTestRunPreset(ctx context.Context, preset *entity.TestRunPreset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTestRunPreset", ctx, preset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTestRunPreset indicates an expected call of SaveTestRunPreset.
+func (mr *MockRepositoryMockRecorder) SaveTestRunPreset(ctx, preset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTestRunPreset", reflect.TypeOf((*MockRepository)(nil).SaveTestRunPreset), ctx, preset)
+}
+
+// ListTestRunPresets mocks base method.
+func (m *MockRepository) ListTestRunPresets(ctx context.Context, workflowID, userID int64) ([]*entity.TestRunPreset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTestRunPresets", ctx, workflowID, userID)
+	ret0, _ := ret[0].([]*entity.TestRunPreset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTestRunPresets indicates an expected call of ListTestRunPresets.
+func (mr *MockRepositoryMockRecorder) ListTestRunPresets(ctx, workflowID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTestRunPresets", reflect.TypeOf((*MockRepository)(nil).ListTestRunPresets), ctx, workflowID, userID)
+}
+
+// DeleteTestRunPreset mocks base method.
+func (m *MockRepository) DeleteTestRunPreset(ctx context.Context, workflowID, userID int64, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTestRunPreset", ctx, workflowID, userID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTestRunPreset indicates an expected call of DeleteTestRunPreset.
+func (mr *MockRepositoryMockRecorder) DeleteTestRunPreset(ctx, workflowID, userID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTestRunPreset", reflect.TypeOf((*MockRepository)(nil).DeleteTestRunPreset), ctx, workflowID, userID, name)
+}
+
 // GetEntity mocks base method.
 func (m *MockRepository) GetEntity(ctx context.Context, policy *vo.GetPolicy) (*entity.Workflow, error) {
 	m.ctrl.T.Helper()
@@ -1450,6 +2035,21 @@ func (mr *MockRepositoryMockRecorder) GetNodeDebugLatestExeID(ctx, wfID, nodeID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeDebugLatestExeID", reflect.TypeOf((*MockRepository)(nil).GetNodeDebugLatestExeID), ctx, wfID, nodeID, uID)
 }
 
+// GetVariantStats mocks base method.
+func (m *MockRepository) GetVariantStats(ctx context.Context, wfID int64, from, to time.Time) ([]*entity.VariantStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantStats", ctx, wfID, from, to)
+	ret0, _ := ret[0].([]*entity.VariantStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantStats indicates an expected call of GetVariantStats.
+func (mr *MockRepositoryMockRecorder) GetVariantStats(ctx, wfID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantStats", reflect.TypeOf((*MockRepository)(nil).GetVariantStats), ctx, wfID, from, to)
+}
+
 // GetNodeExecution mocks base method.
 func (m *MockRepository) GetNodeExecution(ctx context.Context, wfExeID int64, nodeID string) (*entity.NodeExecution, bool, error) {
 	m.ctrl.T.Helper()
@@ -1510,6 +2110,34 @@ func (mr *MockRepositoryMockRecorder) GetNodeOfCodeConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeOfCodeConfig", reflect.TypeOf((*MockRepository)(nil).GetNodeOfCodeConfig))
 }
 
+// GetSpaceExecutionQuotas mocks base method.
+func (m *MockRepository) GetSpaceExecutionQuotas() map[int64]int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpaceExecutionQuotas")
+	ret0, _ := ret[0].(map[int64]int64)
+	return ret0
+}
+
+// GetSpaceExecutionQuotas indicates an expected call of GetSpaceExecutionQuotas.
+func (mr *MockRepositoryMockRecorder) GetSpaceExecutionQuotas() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpaceExecutionQuotas", reflect.TypeOf((*MockRepository)(nil).GetSpaceExecutionQuotas))
+}
+
+// GetPIIScrub mocks base method.
+func (m *MockRepository) GetPIIScrub() *config.PIIScrubConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPIIScrub")
+	ret0, _ := ret[0].(*config.PIIScrubConfig)
+	return ret0
+}
+
+// GetPIIScrub indicates an expected call of GetPIIScrub.
+func (mr *MockRepositoryMockRecorder) GetPIIScrub() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPIIScrub", reflect.TypeOf((*MockRepository)(nil).GetPIIScrub))
+}
+
 // GetObjectUrl mocks base method.
 func (m *MockRepository) GetObjectUrl(ctx context.Context, objectKey string, opts ...storage.GetOptFn) (string, error) {
 	m.ctrl.T.Helper()
@@ -1611,6 +2239,22 @@ func (mr *MockRepositoryMockRecorder) GetTestRunLatestExeID(ctx, wfID, uID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestRunLatestExeID", reflect.TypeOf((*MockRepository)(nil).GetTestRunLatestExeID), ctx, wfID, uID)
 }
 
+// GetLatestWorkflowExecutionByStatus mocks base method.
+func (m *MockRepository) GetLatestWorkflowExecutionByStatus(ctx context.Context, wfID, uID int64, status entity.WorkflowExecuteStatus) (*entity.WorkflowExecution, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestWorkflowExecutionByStatus", ctx, wfID, uID, status)
+	ret0, _ := ret[0].(*entity.WorkflowExecution)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLatestWorkflowExecutionByStatus indicates an expected call of GetLatestWorkflowExecutionByStatus.
+func (mr *MockRepositoryMockRecorder) GetLatestWorkflowExecutionByStatus(ctx, wfID, uID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestWorkflowExecutionByStatus", reflect.TypeOf((*MockRepository)(nil).GetLatestWorkflowExecutionByStatus), ctx, wfID, uID, status)
+}
+
 // GetVersion mocks base method.
 func (m *MockRepository) GetVersion(ctx context.Context, id int64, version string) (*vo.VersionInfo, bool, error) {
 	m.ctrl.T.Helper()
@@ -1642,6 +2286,50 @@ func (mr *MockRepositoryMockRecorder) GetVersionListByConnectorAndWorkflowID(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionListByConnectorAndWorkflowID", reflect.TypeOf((*MockRepository)(nil).GetVersionListByConnectorAndWorkflowID), ctx, connectorID, workflowID, limit)
 }
 
+// DeprecateVersion mocks base method.
+func (m *MockRepository) DeprecateVersion(ctx context.Context, id int64, version, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeprecateVersion", ctx, id, version, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeprecateVersion indicates an expected call of DeprecateVersion.
+func (mr *MockRepositoryMockRecorder) DeprecateVersion(ctx, id, version, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprecateVersion", reflect.TypeOf((*MockRepository)(nil).DeprecateVersion), ctx, id, version, message)
+}
+
+// ListVersions mocks base method.
+func (m *MockRepository) ListVersions(ctx context.Context, id int64) ([]*vo.VersionMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, id)
+	ret0, _ := ret[0].([]*vo.VersionMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockRepositoryMockRecorder) ListVersions(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockRepository)(nil).ListVersions), ctx, id)
+}
+
+// ListVersionsWithCanvas mocks base method.
+func (m *MockRepository) ListVersionsWithCanvas(ctx context.Context, id int64) ([]*vo.VersionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersionsWithCanvas", ctx, id)
+	ret0, _ := ret[0].([]*vo.VersionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersionsWithCanvas indicates an expected call of ListVersionsWithCanvas.
+func (mr *MockRepositoryMockRecorder) ListVersionsWithCanvas(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersionsWithCanvas", reflect.TypeOf((*MockRepository)(nil).ListVersionsWithCanvas), ctx, id)
+}
+
 // GetWorkflowCancelFlag mocks base method.
 func (m *MockRepository) GetWorkflowCancelFlag(ctx context.Context, wfExeID int64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1911,6 +2599,20 @@ func (mr *MockRepositoryMockRecorder) SetWorkflowCancelFlag(ctx, wfExeID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkflowCancelFlag", reflect.TypeOf((*MockRepository)(nil).SetWorkflowCancelFlag), ctx, wfExeID)
 }
 
+// ReleaseEditLock mocks base method.
+func (m *MockRepository) ReleaseEditLock(ctx context.Context, workflowID, holderID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseEditLock", ctx, workflowID, holderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseEditLock indicates an expected call of ReleaseEditLock.
+func (mr *MockRepositoryMockRecorder) ReleaseEditLock(ctx, workflowID, holderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseEditLock", reflect.TypeOf((*MockRepository)(nil).ReleaseEditLock), ctx, workflowID, holderID)
+}
+
 // Suggest mocks base method.
 func (m *MockRepository) Suggest(ctx context.Context, input *vo.SuggestInfo) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1942,6 +2644,21 @@ func (mr *MockRepositoryMockRecorder) TryLockWorkflowExecution(ctx, wfExeID, res
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryLockWorkflowExecution", reflect.TypeOf((*MockRepository)(nil).TryLockWorkflowExecution), ctx, wfExeID, resumingEventID)
 }
 
+// IncrementInterruptCount mocks base method.
+func (m *MockRepository) IncrementInterruptCount(ctx context.Context, wfExeID int64) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementInterruptCount", ctx, wfExeID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementInterruptCount indicates an expected call of IncrementInterruptCount.
+func (mr *MockRepositoryMockRecorder) IncrementInterruptCount(ctx, wfExeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementInterruptCount", reflect.TypeOf((*MockRepository)(nil).IncrementInterruptCount), ctx, wfExeID)
+}
+
 // UpdateChatFlowRoleConfig mocks base method.
 func (m *MockRepository) UpdateChatFlowRoleConfig(ctx context.Context, workflowID int64, chatFlowRole *vo.ChatFlowRoleUpdate) error {
 	m.ctrl.T.Helper()
@@ -42,6 +42,20 @@ func (m *MockImageX) EXPECT() *MockImageXMockRecorder {
 	return m.recorder
 }
 
+// DeleteObject mocks base method.
+func (m *MockImageX) DeleteObject(ctx context.Context, uri string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", ctx, uri)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockImageXMockRecorder) DeleteObject(ctx, uri any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockImageX)(nil).DeleteObject), ctx, uri)
+}
+
 // GetResourceURL mocks base method.
 func (m *MockImageX) GetResourceURL(ctx context.Context, uri string, opts ...imagex.GetResourceOpt) (*imagex.ResourceURL, error) {
 	m.ctrl.T.Helper()
@@ -27,6 +27,7 @@ import (
 	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/adaptor"
 	"github.com/cloudwego/hertz/pkg/common/config"
 	"github.com/hertz-contrib/cors"
 	"github.com/joho/godotenv"
@@ -34,6 +35,7 @@ import (
 	"github.com/coze-dev/coze-studio/backend/api/middleware"
 	"github.com/coze-dev/coze-studio/backend/api/router"
 	"github.com/coze-dev/coze-studio/backend/application"
+	"github.com/coze-dev/coze-studio/backend/infra/metrics"
 	"github.com/coze-dev/coze-studio/backend/pkg/lang/conv"
 	"github.com/coze-dev/coze-studio/backend/pkg/lang/ternary"
 	"github.com/coze-dev/coze-studio/backend/pkg/logs"
@@ -87,15 +89,15 @@ func startHttpServer() {
 	config := cors.DefaultConfig()
 	config.AllowAllOrigins = true
 	config.AllowHeaders = []string{"*"}
-// 	config.AllowOrigins = []string{"http://172.25.1.180:5173"}
+	// 	config.AllowOrigins = []string{"http://172.25.1.180:5173"}
 
-// // 关键：必须允许携带凭证
-// config.AllowCredentials = true
+	// // 关键：必须允许携带凭证
+	// config.AllowCredentials = true
 
-// // 关键：确保允许必要的头部和方法
-// // config.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "X-Requested-With", "Accept"}
-// config.AllowHeaders = []string{"*"}
-// config.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
+	// // 关键：确保允许必要的头部和方法
+	// // config.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "X-Requested-With", "Accept"}
+	// config.AllowHeaders = []string{"*"}
+	// config.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
 
 	corsHandler := cors.New(config)
 
@@ -110,6 +112,8 @@ func startHttpServer() {
 	s.Use(middleware.SessionAuthMW())
 	s.Use(middleware.I18nMW()) // must after SessionAuthMW
 
+	s.GET("/metrics", adaptor.HertzHandler(metrics.Handler()))
+
 	router.GeneratedRegister(s)
 	s.Spin()
 }
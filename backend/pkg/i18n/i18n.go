@@ -25,6 +25,7 @@ type Locale string
 const (
 	LocaleEN Locale = "en-US"
 	LocaleZH Locale = "zh-CN"
+	LocaleJA Locale = "ja-JP"
 )
 
 const key = "i18n.locale.key"
@@ -44,6 +45,8 @@ func GetLocale(ctx context.Context) Locale {
 		return LocaleEN
 	case "zh-CN":
 		return LocaleZH
+	case "ja-JP":
+		return LocaleJA
 	default:
 		return LocaleEN
 	}
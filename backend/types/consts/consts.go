@@ -79,6 +79,7 @@ const (
 
 	SessionDataKeyInCtx = "session_data_key_in_ctx"
 	OpenapiAuthKeyInCtx = "openapi_auth_key_in_ctx"
+	AdminCapKeyInCtx    = "admin_cap_key_in_ctx"
 
 	CodeRunnerType           = "CODE_RUNNER_TYPE"
 	CodeRunnerAllowEnv       = "CODE_RUNNER_ALLOW_ENV"
@@ -67,6 +67,16 @@ const (
 	ErrVariablesAPIFail                 = 777777764
 	ErrInputFieldMissing                = 777777763
 	ErrConversationNotFoundForOperation = 777777762
+	ErrWorkflowPermissionDenied         = 777777761
+	ErrWorkflowDraftCommitConflict      = 777777760
+	ErrWorkflowEditLockHeld             = 777777759
+	ErrConnectorWorkflowNotAllowed      = 777777758
+	ErrConnectorRateLimited             = 777777757
+	ErrResumeDataInvalid                = 777777756
+	ErrMaxInterruptCountExceeded        = 777777755
+	ErrContentModerationBlocked         = 777777754
+	ErrSpaceExecutionQuotaExceeded      = 777777753
+	ErrToolRateLimited                  = 777777752
 )
 
 // stability problems
@@ -346,6 +356,66 @@ func init() {
 		code.WithAffectStability(false),
 	)
 
+	code.Register(
+		ErrWorkflowPermissionDenied,
+		"user {uid} does not have access to space {space_id}",
+		code.WithAffectStability(false),
+	)
+
+	code.Register(
+		ErrWorkflowDraftCommitConflict,
+		"workflow {id} draft has been modified since commit {expected_commit_id}, current commit is {current_commit_id}. Please reload the latest draft and reapply your changes.",
+		code.WithAffectStability(false),
+	)
+
+	code.Register(
+		ErrWorkflowEditLockHeld,
+		"workflow {id} is currently locked for editing by another user (holder {holder_id})",
+		code.WithAffectStability(false),
+	)
+
+	code.Register(
+		ErrConnectorWorkflowNotAllowed,
+		"connector {connector_id} is not allowed to run workflow {id}",
+		code.WithAffectStability(false),
+	)
+
+	code.Register(
+		ErrConnectorRateLimited,
+		"connector {connector_id} rate limit exceeded, please retry later",
+		code.WithAffectStability(false),
+	)
+
+	code.Register(
+		ErrResumeDataInvalid,
+		"resume data is invalid: {cause}",
+		code.WithAffectStability(false),
+	)
+
+	code.Register(
+		ErrMaxInterruptCountExceeded,
+		"workflow execution {id} interrupted {count} times, exceeding the limit of {max}; this is likely an infinite interrupt-resume loop",
+		code.WithAffectStability(false),
+	)
+
+	code.Register(
+		ErrContentModerationBlocked,
+		"output blocked by content moderation: {reason}",
+		code.WithAffectStability(false),
+	)
+
+	code.Register(
+		ErrSpaceExecutionQuotaExceeded,
+		"space {space_id} has reached its daily workflow execution quota of {limit}, please retry tomorrow or contact your administrator to raise the limit",
+		code.WithAffectStability(false),
+	)
+
+	code.Register(
+		ErrToolRateLimited,
+		"tool {tool_id} exceeded its configured rate limit, please retry later",
+		code.WithAffectStability(false),
+	)
+
 }
 
 var errnoMap = map[int]int{